@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"os"
@@ -49,18 +50,29 @@ func main() {
 	}
 
 	challenge := make([]byte, 20)
-	_, err = rand.Read(challenge)
-	if err != nil {
+	if cfg.Challenge != "" {
+		challenge, err = hex.DecodeString(cfg.Challenge)
+		if err != nil {
+			log.Fatal("invalid challenge: ", err)
+		}
+	} else if _, err = rand.Read(challenge); err != nil {
 		panic("no entropy")
 	}
 
-	end := time.Now().Add(cfg.Duration)
 	securityParam := shared.T
 
 	t1 := time.Now()
-	println("Computing dag...")
 	tempdir, _ := os.MkdirTemp("", "poet-test")
-	leafs, merkleProof, err := prover.GenerateProofWithoutPersistency(context.Background(), tempdir, hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), end, securityParam, prover.LowestMerkleMinMemoryLayer)
+	var leafs uint64
+	var merkleProof *shared.MerkleProof
+	if cfg.Leaves != 0 {
+		fmt.Printf("Computing dag of %d leafs...\n", cfg.Leaves)
+		leafs, merkleProof, err = prover.GenerateFixedProofWithoutPersistency(context.Background(), tempdir, hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), shared.FiatShamir, cfg.Leaves, securityParam, prover.LowestMerkleMinMemoryLayer)
+	} else {
+		println("Computing dag...")
+		end := time.Now().Add(cfg.Duration)
+		leafs, merkleProof, err = prover.GenerateProofWithoutPersistency(context.Background(), tempdir, hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), shared.FiatShamir, end, securityParam, prover.LowestMerkleMinMemoryLayer)
+	}
 	if err != nil {
 		panic("failed to generate proof")
 	}
@@ -70,7 +82,7 @@ func main() {
 	fmt.Printf("Dag root label: %x\n", merkleProof.Root)
 
 	t1 = time.Now()
-	err = verifier.Validate(*merkleProof, hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), leafs, securityParam)
+	err = verifier.Validate(*merkleProof, hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), shared.FiatShamir, leafs, securityParam)
 	if err != nil {
 		panic("Failed to verify nip")
 	}
@@ -15,8 +15,10 @@ const (
 
 // config defines the configuration options for bench.
 type config struct {
-	Duration time.Duration `short:"d" description:"benchmark duration"`
-	CPU      bool          `short:"c" description:"whether to enable CPU profiling"`
+	Duration  time.Duration `short:"d" description:"benchmark duration"`
+	Leaves    uint64        `short:"l" description:"number of leaves to generate, overriding the benchmark duration for reproducible results"`
+	Challenge string        `short:"s" description:"hex-encoded challenge, random if not set"`
+	CPU       bool          `short:"c" description:"whether to enable CPU profiling"`
 }
 
 // loadConfig initializes and parses the config using command line options.
@@ -18,7 +18,11 @@ import (
 	"github.com/jessevdk/go-flags"
 
 	"github.com/spacemeshos/poet/appdata"
+	"github.com/spacemeshos/poet/gateway/challenge_verifier"
+	"github.com/spacemeshos/poet/hash"
 	"github.com/spacemeshos/poet/service"
+	"github.com/spacemeshos/poet/tlsconfig"
+	"github.com/spacemeshos/poet/webhook"
 )
 
 const (
@@ -26,6 +30,7 @@ const (
 	defaultDataDirname              = "data"
 	defaultLogDirname               = "logs"
 	defaultLogFilename              = "poet.log"
+	defaultAuditLogFilename         = "audit.log"
 	defaultMaxLogFiles              = 3
 	defaultMaxLogFileSize           = 10
 	defaultRPCPort                  = 50002
@@ -33,6 +38,7 @@ const (
 	defaultMemoryLayers             = 26 // Up to (1 << 26) * 2 - 1 Merkle tree cache nodes (32 bytes each) will be held in-memory
 	defaultConnAcksThreshold        = 1
 	defaultGatewayConnectionTimeout = 30 * time.Second
+	defaultRegistrationsRetention   = 100
 )
 
 var (
@@ -50,6 +56,22 @@ type coreServiceConfig struct {
 	MemoryLayers uint `long:"memory" description:"Number of top Merkle tree layers to cache in-memory"`
 }
 
+type adminConfig struct {
+	RawListener string `long:"listen" description:"The interface/port/socket to listen for admin RPC connections. They are served on the RPC listener if it is not set"`
+	Listener    net.Addr
+	Token       string   `long:"token" description:"Bearer token authenticating the admin RPC calls"`
+	Identities  []string `long:"identity" description:"Common or DNS name of a client certificate authenticating the admin RPC calls, verified with mutual TLS (admin.tls.client-ca, or tls.client-ca if they are served on the RPC listener). May be repeated"`
+	AuditLog    string   `long:"audit-log" description:"File to write the audit log of the admin RPC calls to"`
+
+	// TLS configures the admin listener. It serves the TLS of the RPC listener if it is not set.
+	TLS *tlsconfig.Server `group:"Admin TLS" namespace:"tls"`
+}
+
+// Enabled returns whether the admin RPCs are served, which requires a way to authenticate their callers.
+func (c *adminConfig) Enabled() bool {
+	return c.Token != "" || len(c.Identities) != 0
+}
+
 // Config defines the configuration options for poet.
 //
 // See loadConfig for further details regarding the
@@ -69,11 +91,16 @@ type Config struct {
 	RESTListener    net.Addr
 	GtwConnTimeout  time.Duration `long:"gtw-connection-timeout" description:"Timeout for connecting to gateway"`
 
+	TLS    *tlsconfig.Server `group:"TLS" namespace:"tls"`
+	GtwTLS *tlsconfig.Client `group:"Gateway TLS" namespace:"gtw-tls"`
+
 	CPUProfile string `long:"cpuprofile" description:"Write CPU profile to the specified file"`
 	Profile    string `long:"profile" description:"Enable HTTP profiling on given port -- must be between 1024 and 65535"`
 
 	CoreServiceMode bool `long:"core" description:"Enable poet in core service mode"`
 
+	Admin       *adminConfig       `group:"Admin" namespace:"admin"`
+	Webhook     *webhook.Config    `group:"Webhook" namespace:"webhook"`
 	CoreService *coreServiceConfig `group:"Core Service" namespace:"core"`
 	Service     *service.Config    `group:"Service"`
 }
@@ -90,6 +117,13 @@ func DefaultConfig() *Config {
 		RawRPCListener:  fmt.Sprintf("localhost:%d", defaultRPCPort),
 		RawRESTListener: fmt.Sprintf("localhost:%d", defaultRESTPort),
 		GtwConnTimeout:  defaultGatewayConnectionTimeout,
+		TLS:             &tlsconfig.Server{},
+		GtwTLS:          &tlsconfig.Client{},
+		Admin:           &adminConfig{TLS: &tlsconfig.Server{}},
+		Webhook: &webhook.Config{
+			Timeout:     webhook.DefaultTimeout,
+			MaxAttempts: webhook.DefaultMaxAttempts,
+		},
 		Service: &service.Config{
 			Genesis:           defaultGenesisTime,
 			EpochDuration:     defaultEpochDuration,
@@ -97,6 +131,13 @@ func DefaultConfig() *Config {
 			CycleGap:          defaultCycleGap,
 			MemoryLayers:      defaultMemoryLayers,
 			ConnAcksThreshold: defaultConnAcksThreshold,
+			HashSuite:         hash.SHA256,
+
+			RegistrationsRetention: defaultRegistrationsRetention,
+
+			VerifierCacheSize:       challenge_verifier.DefaultCacheSize,
+			VerifierCacheValidTTL:   challenge_verifier.DefaultCacheValidTTL,
+			VerifierCacheInvalidTTL: challenge_verifier.DefaultCacheInvalidTTL,
 		},
 		CoreService: &coreServiceConfig{
 			MemoryLayers: defaultMemoryLayers,
@@ -104,6 +145,15 @@ func DefaultConfig() *Config {
 	}
 }
 
+// AdminTLS returns the TLS config of the listener serving the admin RPCs.
+// The admin listener has its own, so that mutual TLS can be required for the admin RPCs only.
+func (c *Config) AdminTLS() *tlsconfig.Server {
+	if c.Admin.Listener != nil && c.Admin.TLS.Enabled() {
+		return c.Admin.TLS
+	}
+	return c.TLS
+}
+
 // ParseFlags reads values from command line arguments.
 func ParseFlags(preCfg *Config) (*Config, error) {
 	if _, err := flags.Parse(preCfg); err != nil {
@@ -174,6 +224,20 @@ func SetupConfig(cfg *Config) (*Config, error) {
 	}
 	cfg.RESTListener = addr
 
+	// Resolve the admin listener, if the admin RPCs are served separately
+	if cfg.Admin.RawListener != "" {
+		addr, err = net.ResolveTCPAddr("tcp", cfg.Admin.RawListener)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Admin.Listener = addr
+	}
+
+	if cfg.Admin.AuditLog == "" {
+		cfg.Admin.AuditLog = filepath.Join(cfg.LogDir, defaultAuditLogFilename)
+	}
+	cfg.Admin.AuditLog = cleanAndExpandPath(cfg.Admin.AuditLog)
+
 	return cfg, nil
 }
 
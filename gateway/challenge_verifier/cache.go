@@ -0,0 +1,471 @@
+package challenge_verifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/minio/sha256-simd"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"go.uber.org/zap"
+
+	"github.com/spacemeshos/poet/logging"
+)
+
+const (
+	// DefaultCacheSize is the maximum number of results kept in the cache.
+	DefaultCacheSize = 100_000
+	// DefaultCacheValidTTL is how long the cache keeps results of valid challenges.
+	DefaultCacheValidTTL = 24 * time.Hour
+	// DefaultCacheInvalidTTL is how long the cache keeps results of invalid challenges.
+	// It is short because a challenge may become valid once the gateways see the ATX it refers to.
+	DefaultCacheInvalidTTL = 5 * time.Minute
+)
+
+// cacheFormatVersion is the version of the layout of the cache.
+// A cache of another version is emptied when opened, its results are verified again.
+const cacheFormatVersion uint32 = 1
+
+var (
+	// resultPrefix prefixes the keys of the results: resultPrefix || cache key.
+	resultPrefix = []byte("r")
+	// expiryPrefix prefixes the keys of the index of results by expiry: expiryPrefix || expiry || cache key.
+	expiryPrefix = []byte("e")
+	// versionKey is the key of the version of the layout of the cache.
+	versionKey = []byte("v")
+	// fingerprintKey is the key of the fingerprint of the config of the verifier whose results are cached.
+	fingerprintKey = []byte("f")
+)
+
+// CacheOption configures a Cache.
+type CacheOption func(*Cache)
+
+// WithCacheSize sets the maximum number of results kept in the cache.
+// The results expiring first are evicted when the cache is full.
+func WithCacheSize(size int) CacheOption {
+	return func(c *Cache) {
+		c.size = size
+	}
+}
+
+// WithCacheTTL sets how long the cache keeps results of valid and invalid challenges.
+// Results are not cached at all with a TTL of 0.
+func WithCacheTTL(valid, invalid time.Duration) CacheOption {
+	return func(c *Cache) {
+		c.validTTL = valid
+		c.invalidTTL = invalid
+	}
+}
+
+// CacheStats are the statistics of a Cache since it was opened.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	// Entries is the number of results in the cache.
+	Entries int
+}
+
+// Cache keeps verification results in a LevelDB database so they survive restarts.
+// It is shared by the verifiers created with NewPersistentCaching.
+type Cache struct {
+	db         *leveldb.DB
+	size       int
+	validTTL   time.Duration
+	invalidTTL time.Duration
+
+	// mu serializes writes so that `entries` matches the database.
+	mu      sync.Mutex
+	entries int
+	// fingerprint is the fingerprint of the config of the verifier whose results are cached, see Bind.
+	fingerprint []byte
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+// OpenCache opens the cache stored in the database at `path`.
+func OpenCache(path string, opts ...CacheOption) (*Cache, error) {
+	c := &Cache{
+		size:       DefaultCacheSize,
+		validTTL:   DefaultCacheValidTTL,
+		invalidTTL: DefaultCacheInvalidTTL,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database @ %s: %w", path, err)
+	}
+	c.db = db
+	if err := c.checkVersion(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	c.fingerprint, err = db.Get(fingerprintKey, nil)
+	if err != nil && !errors.Is(err, leveldb.ErrNotFound) {
+		db.Close()
+		return nil, fmt.Errorf("failed to read the cache fingerprint: %w", err)
+	}
+
+	iter := db.NewIterator(util.BytesPrefix(resultPrefix), nil)
+	for iter.Next() {
+		c.entries++
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to count cached results: %w", err)
+	}
+	openCaches.add(c)
+	return c, nil
+}
+
+// checkVersion empties the cache if it has another layout than the current one.
+func (c *Cache) checkVersion() error {
+	data, err := c.db.Get(versionKey, nil)
+	switch {
+	case err == nil && len(data) == 4 && binary.BigEndian.Uint32(data) == cacheFormatVersion:
+		return nil
+	case err != nil && !errors.Is(err, leveldb.ErrNotFound):
+		return fmt.Errorf("failed to read the cache version: %w", err)
+	}
+
+	return c.empty(nil)
+}
+
+// empty deletes the results of the cache, and writes its version and the given fingerprint.
+func (c *Cache) empty(fingerprint []byte) error {
+	batch := new(leveldb.Batch)
+	iter := c.db.NewIterator(nil, nil)
+	for iter.Next() {
+		batch.Delete(iter.Key())
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("failed to empty the cache: %w", err)
+	}
+	batch.Put(versionKey, binary.BigEndian.AppendUint32(nil, cacheFormatVersion))
+	if fingerprint != nil {
+		batch.Put(fingerprintKey, fingerprint)
+	}
+	if err := c.db.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
+		return fmt.Errorf("failed to empty the cache: %w", err)
+	}
+	return nil
+}
+
+// Bind binds the cache to the config of the verifier whose results it caches, identified by `fingerprint`.
+// The cache is emptied if it holds the results of a verifier of another config, e.g. with other allowed
+// node keys or other gateways, as these results may no longer hold.
+// The verifiers created with NewPersistentCaching before binding the cache to another config bypass it.
+func (c *Cache) Bind(fingerprint []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if bytes.Equal(c.fingerprint, fingerprint) {
+		return nil
+	}
+	if err := c.empty(fingerprint); err != nil {
+		return err
+	}
+	c.fingerprint = append([]byte(nil), fingerprint...)
+	c.entries = 0
+	return nil
+}
+
+// bound returns whether the cache is bound to the config of the given fingerprint.
+func (c *Cache) bound(fingerprint []byte) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return bytes.Equal(c.fingerprint, fingerprint)
+}
+
+// Close closes the database of the cache.
+func (c *Cache) Close() error {
+	openCaches.remove(c)
+	return c.db.Close()
+}
+
+// Stats returns the statistics of the cache.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	entries := c.entries
+	c.mu.Unlock()
+	return CacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Entries:   entries,
+	}
+}
+
+const (
+	cachedInvalid byte = iota
+	// cachedValid is a valid result cached with its verifiers and verification time.
+	cachedValid
+)
+
+// cachedResult is a result stored in the cache.
+// It is encoded as: expiry (unix nanoseconds) || kind (1 byte) || result, where the result of
+// a valid challenge is: varint len(hash) || hash || varint len(node ID) || node ID ||
+// verification time (unix nanoseconds) || varint number of verifiers || (varint len(verifier) || verifier)*.
+type cachedResult struct {
+	expiry time.Time
+	result *Result
+}
+
+func (r *cachedResult) encode() []byte {
+	data := binary.BigEndian.AppendUint64(nil, uint64(r.expiry.UnixNano()))
+	if r.result == nil {
+		return append(data, cachedInvalid)
+	}
+	data = append(data, cachedValid)
+	data = appendBytes(data, r.result.Hash)
+	data = appendBytes(data, r.result.NodeId)
+	var verifiedAt uint64
+	if !r.result.VerifiedAt.IsZero() {
+		verifiedAt = uint64(r.result.VerifiedAt.UnixNano())
+	}
+	data = binary.BigEndian.AppendUint64(data, verifiedAt)
+	data = binary.AppendUvarint(data, uint64(len(r.result.Verifiers)))
+	for _, verifier := range r.result.Verifiers {
+		data = appendBytes(data, []byte(verifier))
+	}
+	return data
+}
+
+func appendBytes(data, b []byte) []byte {
+	data = binary.AppendUvarint(data, uint64(len(b)))
+	return append(data, b...)
+}
+
+var errMalformedCachedResult = errors.New("cached result malformed")
+
+// resultDecoder decodes the fields of a cached result, stopping at the first error.
+type resultDecoder struct {
+	data []byte
+	err  error
+}
+
+func (d *resultDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errMalformedCachedResult
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *resultDecoder) bytes() []byte {
+	n := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if uint64(len(d.data)) < n {
+		d.err = errMalformedCachedResult
+		return nil
+	}
+	b := append([]byte{}, d.data[:n]...)
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *resultDecoder) uint64() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.data) < 8 {
+		d.err = errMalformedCachedResult
+		return 0
+	}
+	v := binary.BigEndian.Uint64(d.data)
+	d.data = d.data[8:]
+	return v
+}
+
+func decodeCachedResult(data []byte) (*cachedResult, error) {
+	d := &resultDecoder{data: data}
+	r := &cachedResult{expiry: time.Unix(0, int64(d.uint64()))}
+	if d.err != nil || len(d.data) == 0 {
+		return nil, errMalformedCachedResult
+	}
+	kind := d.data[0]
+	d.data = d.data[1:]
+	switch kind {
+	case cachedInvalid:
+		return r, nil
+	case cachedValid:
+		r.result = &Result{Hash: d.bytes(), NodeId: d.bytes()}
+		if verifiedAt := d.uint64(); verifiedAt != 0 {
+			r.result.VerifiedAt = time.Unix(0, int64(verifiedAt))
+		}
+		verifiers := d.uvarint()
+		for i := uint64(0); i < verifiers && d.err == nil; i++ {
+			r.result.Verifiers = append(r.result.Verifiers, string(d.bytes()))
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown kind %d", errMalformedCachedResult, kind)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return r, nil
+}
+
+func resultKey(key [sha256.Size]byte) []byte {
+	return append(append([]byte{}, resultPrefix...), key[:]...)
+}
+
+func expiryKey(key []byte, expiry time.Time) []byte {
+	out := append([]byte{}, expiryPrefix...)
+	out = binary.BigEndian.AppendUint64(out, uint64(expiry.UnixNano()))
+	return append(out, key...)
+}
+
+// get returns the result cached for `key`, or nil if there is none or it expired.
+// The result of an invalid challenge has a nil `result`.
+func (c *Cache) get(key [sha256.Size]byte) *cachedResult {
+	data, err := c.db.Get(resultKey(key), nil)
+	if err != nil {
+		c.misses.Add(1)
+		return nil
+	}
+	cached, err := decodeCachedResult(data)
+	if err != nil || !time.Now().Before(cached.expiry) {
+		c.misses.Add(1)
+		return nil
+	}
+	c.hits.Add(1)
+	return cached
+}
+
+// put caches the result of the verification of `key` by a verifier of the given fingerprint.
+// Only valid results and ErrChallengeInvalid are cached.
+func (c *Cache) put(fingerprint []byte, key [sha256.Size]byte, result *Result, err error) error {
+	ttl := c.validTTL
+	switch {
+	case errors.Is(err, ErrChallengeInvalid):
+		ttl = c.invalidTTL
+		result = nil
+	case err != nil:
+		return nil
+	}
+	if ttl <= 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !bytes.Equal(c.fingerprint, fingerprint) {
+		return nil
+	}
+
+	rKey := resultKey(key)
+	cached := &cachedResult{expiry: time.Now().Add(ttl), result: result}
+	batch := new(leveldb.Batch)
+	added := 1
+	if data, err := c.db.Get(rKey, nil); err == nil {
+		added = 0
+		if old, err := decodeCachedResult(data); err == nil {
+			batch.Delete(expiryKey(rKey, old.expiry))
+		}
+	}
+	batch.Put(rKey, cached.encode())
+	batch.Put(expiryKey(rKey, cached.expiry), nil)
+	if err := c.db.Write(batch, nil); err != nil {
+		return fmt.Errorf("failed to cache result: %w", err)
+	}
+	c.entries += added
+	return c.evict()
+}
+
+// evict removes the expired results and the results expiring first
+// while there are more than the size of the cache. It must be called with `mu` held.
+func (c *Cache) evict() error {
+	now := uint64(time.Now().UnixNano())
+	iter := c.db.NewIterator(util.BytesPrefix(expiryPrefix), nil)
+	defer iter.Release()
+
+	batch := new(leveldb.Batch)
+	evicted := 0
+	for iter.Next() {
+		key := iter.Key()[len(expiryPrefix):]
+		expiry := binary.BigEndian.Uint64(key)
+		if c.entries-evicted <= c.size && expiry > now {
+			break
+		}
+		batch.Delete(iter.Key())
+		batch.Delete(key[8:])
+		evicted++
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("failed to iterate cached results: %w", err)
+	}
+	if evicted == 0 {
+		return nil
+	}
+	if err := c.db.Write(batch, nil); err != nil {
+		return fmt.Errorf("failed to evict cached results: %w", err)
+	}
+	c.entries -= evicted
+	c.evictions.Add(uint64(evicted))
+	return nil
+}
+
+// persistentCaching caches the results of its verifier in a Cache,
+// as long as the cache is bound to the config of the verifier.
+type persistentCaching struct {
+	cache       *Cache
+	fingerprint []byte
+	verifier    Verifier
+}
+
+func (a *persistentCaching) Verify(ctx context.Context, challenge, signature []byte) (*Result, error) {
+	if !a.cache.bound(a.fingerprint) {
+		return a.verifier.Verify(ctx, challenge, signature)
+	}
+	key := cacheKey(challenge, signature)
+	logger := logging.FromContext(ctx).With(zap.Binary("challenge", key[:]))
+	if cached := a.cache.get(key); cached != nil {
+		logger.Debug("retrieved challenge verifier result from the persistent cache")
+		observeCacheLookup(cached.result, true)
+		if cached.result == nil {
+			return nil, ErrChallengeInvalid
+		}
+		return cached.result, nil
+	}
+
+	result, err := a.verifier.Verify(ctx, challenge, signature)
+	observeCacheLookup(result, false)
+	if err := a.cache.put(a.fingerprint, key, result, err); err != nil {
+		logger.Warn("failed to cache challenge verifier result", zap.Error(err))
+	}
+	return result, err
+}
+
+// NewPersistentCaching creates a Verifier caching the results of `verifier` in `cache`,
+// while the cache is bound to the config it is bound to now.
+func NewPersistentCaching(cache *Cache, verifier Verifier) Verifier {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	return &persistentCaching{
+		cache:       cache,
+		fingerprint: cache.fingerprint,
+		verifier:    verifier,
+	}
+}
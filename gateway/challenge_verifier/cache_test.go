@@ -0,0 +1,172 @@
+package challenge_verifier_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/spacemeshos/poet/gateway/challenge_verifier"
+)
+
+func TestPersistentCachingVerifier(t *testing.T) {
+	t.Parallel()
+	expected := &challenge_verifier.Result{
+		Hash:       []byte("hash"),
+		NodeId:     []byte("node"),
+		Verifiers:  []string{"gateway-1", "gateway-2"},
+		VerifiedAt: time.Unix(1234, 5678),
+	}
+
+	t.Run("survives reopening", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "cache")
+		cache, err := challenge_verifier.OpenCache(path)
+		require.NoError(t, err)
+		valid := &counting{Verifier: answering(expected, nil)}
+		invalid := &counting{Verifier: answering(nil, challenge_verifier.ErrChallengeInvalid)}
+
+		_, err = challenge_verifier.NewPersistentCaching(cache, valid).Verify(context.Background(), []byte("valid"), nil)
+		require.NoError(t, err)
+		_, err = challenge_verifier.NewPersistentCaching(cache, invalid).Verify(context.Background(), []byte("invalid"), nil)
+		require.ErrorIs(t, err, challenge_verifier.ErrChallengeInvalid)
+		require.NoError(t, cache.Close())
+
+		cache, err = challenge_verifier.OpenCache(path)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, cache.Close()) })
+		require.Equal(t, 2, cache.Stats().Entries)
+
+		result, err := challenge_verifier.NewPersistentCaching(cache, valid).Verify(context.Background(), []byte("valid"), nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+		_, err = challenge_verifier.NewPersistentCaching(cache, invalid).Verify(context.Background(), []byte("invalid"), nil)
+		require.ErrorIs(t, err, challenge_verifier.ErrChallengeInvalid)
+
+		require.EqualValues(t, 1, valid.calls.Load())
+		require.EqualValues(t, 1, invalid.calls.Load())
+		require.Equal(t, challenge_verifier.CacheStats{Hits: 2, Entries: 2}, cache.Stats())
+	})
+	t.Run("empties a cache of another version", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "cache")
+		cache, err := challenge_verifier.OpenCache(path)
+		require.NoError(t, err)
+		_, err = challenge_verifier.NewPersistentCaching(cache, answering(expected, nil)).Verify(context.Background(), []byte("challenge"), nil)
+		require.NoError(t, err)
+		require.NoError(t, cache.Close())
+
+		db, err := leveldb.OpenFile(path, nil)
+		require.NoError(t, err)
+		require.NoError(t, db.Delete([]byte("v"), nil))
+		require.NoError(t, db.Close())
+
+		cache, err = challenge_verifier.OpenCache(path)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, cache.Close()) })
+		require.Zero(t, cache.Stats().Entries)
+	})
+	t.Run("empties a cache bound to another verifier", func(t *testing.T) {
+		t.Parallel()
+		path := filepath.Join(t.TempDir(), "cache")
+		cache, err := challenge_verifier.OpenCache(path)
+		require.NoError(t, err)
+		require.NoError(t, cache.Bind([]byte("first")))
+		stale := challenge_verifier.NewPersistentCaching(cache, answering(expected, nil))
+		_, err = stale.Verify(context.Background(), []byte("challenge"), nil)
+		require.NoError(t, err)
+		require.NoError(t, cache.Close())
+
+		cache, err = challenge_verifier.OpenCache(path)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, cache.Close()) })
+		require.NoError(t, cache.Bind([]byte("first")))
+		require.Equal(t, 1, cache.Stats().Entries)
+		stale = challenge_verifier.NewPersistentCaching(cache, answering(expected, nil))
+
+		require.NoError(t, cache.Bind([]byte("second")))
+		require.Zero(t, cache.Stats().Entries)
+		invalid := &counting{Verifier: answering(nil, challenge_verifier.ErrChallengeInvalid)}
+		_, err = challenge_verifier.NewPersistentCaching(cache, invalid).Verify(context.Background(), []byte("challenge"), nil)
+		require.ErrorIs(t, err, challenge_verifier.ErrChallengeInvalid)
+
+		// A verifier created before rebinding bypasses the cache.
+		_, err = stale.Verify(context.Background(), []byte("challenge"), nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, cache.Stats().Entries)
+	})
+	t.Run("does not cache failures", func(t *testing.T) {
+		t.Parallel()
+		cache, err := challenge_verifier.OpenCache(filepath.Join(t.TempDir(), "cache"))
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, cache.Close()) })
+		failing := &counting{Verifier: answering(nil, challenge_verifier.ErrCouldNotVerify)}
+		verifier := challenge_verifier.NewPersistentCaching(cache, failing)
+
+		for i := 0; i < 2; i++ {
+			_, err := verifier.Verify(context.Background(), []byte("challenge"), nil)
+			require.ErrorIs(t, err, challenge_verifier.ErrCouldNotVerify)
+		}
+		require.EqualValues(t, 2, failing.calls.Load())
+		require.Equal(t, challenge_verifier.CacheStats{Misses: 2}, cache.Stats())
+	})
+	t.Run("results expire", func(t *testing.T) {
+		t.Parallel()
+		cache, err := challenge_verifier.OpenCache(
+			filepath.Join(t.TempDir(), "cache"),
+			challenge_verifier.WithCacheTTL(time.Hour, time.Millisecond),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, cache.Close()) })
+		invalid := &counting{Verifier: answering(nil, challenge_verifier.ErrChallengeInvalid)}
+		verifier := challenge_verifier.NewPersistentCaching(cache, invalid)
+
+		_, err = verifier.Verify(context.Background(), []byte("challenge"), nil)
+		require.ErrorIs(t, err, challenge_verifier.ErrChallengeInvalid)
+		time.Sleep(10 * time.Millisecond)
+		_, err = verifier.Verify(context.Background(), []byte("challenge"), nil)
+		require.ErrorIs(t, err, challenge_verifier.ErrChallengeInvalid)
+		require.EqualValues(t, 2, invalid.calls.Load())
+	})
+	t.Run("not cached without TTL", func(t *testing.T) {
+		t.Parallel()
+		cache, err := challenge_verifier.OpenCache(
+			filepath.Join(t.TempDir(), "cache"),
+			challenge_verifier.WithCacheTTL(0, time.Hour),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, cache.Close()) })
+
+		_, err = challenge_verifier.NewPersistentCaching(cache, answering(expected, nil)).Verify(context.Background(), []byte("challenge"), nil)
+		require.NoError(t, err)
+		require.Zero(t, cache.Stats().Entries)
+	})
+	t.Run("evicts the results expiring first", func(t *testing.T) {
+		t.Parallel()
+		cache, err := challenge_verifier.OpenCache(
+			filepath.Join(t.TempDir(), "cache"),
+			challenge_verifier.WithCacheSize(2),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, cache.Close()) })
+		valid := &counting{Verifier: answering(expected, nil)}
+		verifier := challenge_verifier.NewPersistentCaching(cache, valid)
+
+		for _, challenge := range []string{"first", "second", "third"} {
+			_, err := verifier.Verify(context.Background(), []byte(challenge), nil)
+			require.NoError(t, err)
+		}
+		stats := cache.Stats()
+		require.Equal(t, 2, stats.Entries)
+		require.EqualValues(t, 1, stats.Evictions)
+
+		for _, challenge := range []string{"second", "third", "first"} {
+			_, err := verifier.Verify(context.Background(), []byte(challenge), nil)
+			require.NoError(t, err)
+		}
+		require.EqualValues(t, 4, valid.calls.Load())
+	})
+}
@@ -2,6 +2,7 @@ package challenge_verifier
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/spacemeshos/api/release/go/spacemesh/v1"
 	"go.uber.org/zap"
@@ -18,6 +19,13 @@ type grpcChallengeVerifierClient struct {
 }
 
 func (c *grpcChallengeVerifierClient) Verify(ctx context.Context, challenge, signature []byte) (*Result, error) {
+	start := time.Now()
+	result, err := c.verify(ctx, challenge, signature)
+	observeVerification(c.gateway, start, err)
+	return result, err
+}
+
+func (c *grpcChallengeVerifierClient) verify(ctx context.Context, challenge, signature []byte) (*Result, error) {
 	logger := logging.FromContext(ctx).With(zap.String("gateway", c.gateway))
 
 	resp, err := c.client.VerifyChallenge(ctx, &pb.VerifyChallengeRequest{
@@ -29,8 +37,10 @@ func (c *grpcChallengeVerifierClient) Verify(ctx context.Context, challenge, sig
 	switch st.Code() {
 	case codes.OK:
 		return &Result{
-			Hash:   resp.Hash,
-			NodeId: resp.NodeId,
+			Hash:       resp.Hash,
+			NodeId:     resp.NodeId,
+			Verifiers:  []string{c.gateway},
+			VerifiedAt: time.Now(),
 		}, nil
 	case codes.InvalidArgument:
 		logger.Debug("challenge is invalid", zap.String("message", st.Message()))
@@ -41,6 +51,10 @@ func (c *grpcChallengeVerifierClient) Verify(ctx context.Context, challenge, sig
 	}
 }
 
+func (c *grpcChallengeVerifierClient) String() string {
+	return c.gateway
+}
+
 func NewClient(conn *grpc.ClientConn) Verifier {
 	return &grpcChallengeVerifierClient{
 		client:  pb.NewGatewayServiceClient(conn),
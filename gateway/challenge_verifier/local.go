@@ -0,0 +1,87 @@
+package challenge_verifier
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"time"
+
+	"github.com/minio/sha256-simd"
+)
+
+// LocalSignatureSize is the size of the signatures verified by the local verifier:
+// the ed25519 public key of the node followed by its ed25519 signature over the challenge.
+const LocalSignatureSize = ed25519.PublicKeySize + ed25519.SignatureSize
+
+// LocalVerifierName names the local verifier in the results it verified.
+const LocalVerifierName = "local"
+
+// KeyPolicy decides whether challenges signed with the given node key are accepted.
+type KeyPolicy func(nodeKey ed25519.PublicKey) bool
+
+// AllowAllKeys is the KeyPolicy accepting challenges from any node.
+func AllowAllKeys(ed25519.PublicKey) bool {
+	return true
+}
+
+// AllowKeys returns a KeyPolicy accepting challenges only from the nodes of the given keys.
+func AllowKeys(keys ...ed25519.PublicKey) KeyPolicy {
+	allowed := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		allowed[string(key)] = struct{}{}
+	}
+	return func(nodeKey ed25519.PublicKey) bool {
+		_, ok := allowed[string(nodeKey)]
+		return ok
+	}
+}
+
+// local verifies challenges without a gateway.
+// The signature of a challenge is made of the ed25519 public key of the node that signed it,
+// which is the ID of the node, followed by the signature.
+type local struct {
+	policy KeyPolicy
+}
+
+func (l *local) Verify(_ context.Context, challenge, signature []byte) (*Result, error) {
+	start := time.Now()
+	result, err := l.verify(challenge, signature)
+	observeVerification(LocalVerifierName, start, err)
+	return result, err
+}
+
+func (l *local) verify(challenge, signature []byte) (*Result, error) {
+	if len(signature) != LocalSignatureSize {
+		return nil, fmt.Errorf("%w: signature must be %d bytes long, got %d", ErrChallengeInvalid, LocalSignatureSize, len(signature))
+	}
+	nodeKey := ed25519.PublicKey(signature[:ed25519.PublicKeySize])
+	if !l.policy(nodeKey) {
+		return nil, fmt.Errorf("%w: node %x is not allowed", ErrChallengeInvalid, []byte(nodeKey))
+	}
+	if !ed25519.Verify(nodeKey, challenge, signature[ed25519.PublicKeySize:]) {
+		return nil, fmt.Errorf("%w: signature is not valid", ErrChallengeInvalid)
+	}
+
+	hash := sha256.Sum256(challenge)
+	nodeID := make([]byte, ed25519.PublicKeySize)
+	copy(nodeID, nodeKey)
+	return &Result{
+		Hash:       hash[:],
+		NodeId:     nodeID,
+		Verifiers:  []string{LocalVerifierName},
+		VerifiedAt: time.Now(),
+	}, nil
+}
+
+// NewLocal creates a Verifier checking the signatures of the challenges locally,
+// accepting the challenges of the nodes allowed by the policy.
+func NewLocal(policy KeyPolicy) Verifier {
+	return &local{policy: policy}
+}
+
+// SignLocal signs a challenge for the local verifier with the key of a node.
+func SignLocal(key ed25519.PrivateKey, challenge []byte) []byte {
+	signature := make([]byte, 0, LocalSignatureSize)
+	signature = append(signature, key.Public().(ed25519.PublicKey)...)
+	return append(signature, ed25519.Sign(key, challenge)...)
+}
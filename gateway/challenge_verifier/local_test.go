@@ -0,0 +1,51 @@
+package challenge_verifier_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spacemeshos/poet/gateway/challenge_verifier"
+)
+
+func TestLocalVerifier(t *testing.T) {
+	t.Parallel()
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	challenge := []byte("challenge")
+	signature := challenge_verifier.SignLocal(privKey, challenge)
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+		verifier := challenge_verifier.NewLocal(challenge_verifier.AllowAllKeys)
+		result, err := verifier.Verify(context.Background(), challenge, signature)
+		require.NoError(t, err)
+		hash := sha256.Sum256(challenge)
+		require.Equal(t, hash[:], result.Hash)
+		require.Equal(t, []byte(pubKey), result.NodeId)
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		t.Parallel()
+		verifier := challenge_verifier.NewLocal(challenge_verifier.AllowAllKeys)
+		_, err := verifier.Verify(context.Background(), []byte("other challenge"), signature)
+		require.ErrorIs(t, err, challenge_verifier.ErrChallengeInvalid)
+		_, err = verifier.Verify(context.Background(), challenge, signature[1:])
+		require.ErrorIs(t, err, challenge_verifier.ErrChallengeInvalid)
+	})
+	t.Run("key policy", func(t *testing.T) {
+		t.Parallel()
+		otherKey, _, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+
+		verifier := challenge_verifier.NewLocal(challenge_verifier.AllowKeys(otherKey))
+		_, err = verifier.Verify(context.Background(), challenge, signature)
+		require.ErrorIs(t, err, challenge_verifier.ErrChallengeInvalid)
+
+		verifier = challenge_verifier.NewLocal(challenge_verifier.AllowKeys(otherKey, pubKey))
+		_, err = verifier.Verify(context.Background(), challenge, signature)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,134 @@
+package challenge_verifier
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	metricsNamespace = "poet"
+	metricsSubsystem = "verifier"
+)
+
+var (
+	verifierRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "requests_total",
+		Help:      "Number of challenge verifications, by verifier and result (valid, invalid or failed).",
+	}, []string{"verifier", "result"})
+	verifierLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the challenge verifications, by verifier.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"verifier"})
+	cacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "cache_lookups_total",
+		Help: "Lookups of challenge verification results in the caches, by the verifier of the result and whether it was cached (hit or miss). " +
+			"The verifier of invalid or failed results is none.",
+	}, []string{"verifier", "result"})
+)
+
+// observeVerification records a verification of the named verifier started at `start`.
+func observeVerification(verifier string, start time.Time, err error) {
+	verifierLatency.WithLabelValues(verifier).Observe(time.Since(start).Seconds())
+	result := "valid"
+	switch {
+	case errors.Is(err, ErrChallengeInvalid):
+		result = "invalid"
+	case err != nil:
+		result = "failed"
+	}
+	verifierRequests.WithLabelValues(verifier, result).Inc()
+}
+
+// observeCacheLookup records a cache lookup, attributing it to the verifiers of the result.
+func observeCacheLookup(result *Result, hit bool) {
+	lookup := "miss"
+	if hit {
+		lookup = "hit"
+	}
+	if result == nil || len(result.Verifiers) == 0 {
+		cacheLookups.WithLabelValues("none", lookup).Inc()
+		return
+	}
+	for _, verifier := range result.Verifiers {
+		cacheLookups.WithLabelValues(verifier, lookup).Inc()
+	}
+}
+
+// cacheCollector exports the stats of the open caches.
+type cacheCollector struct {
+	entries   *prometheus.Desc
+	hits      *prometheus.Desc
+	misses    *prometheus.Desc
+	evictions *prometheus.Desc
+
+	mu     sync.Mutex
+	caches map[*Cache]struct{}
+}
+
+var openCaches = &cacheCollector{
+	entries: prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "cache_entries"),
+		"Number of challenge verification results in the persistent cache.", nil, nil),
+	hits: prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "cache_hits_total"),
+		"Number of lookups served by the persistent cache.", nil, nil),
+	misses: prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "cache_misses_total"),
+		"Number of lookups missing the persistent cache.", nil, nil),
+	evictions: prometheus.NewDesc(
+		prometheus.BuildFQName(metricsNamespace, metricsSubsystem, "cache_evictions_total"),
+		"Number of results evicted from the persistent cache, expired or over its size.", nil, nil),
+	caches: make(map[*Cache]struct{}),
+}
+
+func init() {
+	prometheus.MustRegister(openCaches)
+}
+
+func (c *cacheCollector) add(cache *Cache) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.caches[cache] = struct{}{}
+}
+
+func (c *cacheCollector) remove(cache *Cache) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.caches, cache)
+}
+
+func (c *cacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.entries
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.evictions
+}
+
+func (c *cacheCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	var total CacheStats
+	for cache := range c.caches {
+		stats := cache.Stats()
+		total.Entries += stats.Entries
+		total.Hits += stats.Hits
+		total.Misses += stats.Misses
+		total.Evictions += stats.Evictions
+	}
+	c.mu.Unlock()
+
+	ch <- prometheus.MustNewConstMetric(c.entries, prometheus.GaugeValue, float64(total.Entries))
+	ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(total.Hits))
+	ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(total.Misses))
+	ch <- prometheus.MustNewConstMetric(c.evictions, prometheus.CounterValue, float64(total.Evictions))
+}
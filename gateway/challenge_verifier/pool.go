@@ -0,0 +1,232 @@
+package challenge_verifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/spacemeshos/poet/logging"
+)
+
+const (
+	// DefaultHedgeDelay is how long the pool waits for a verifier to answer
+	// before sending a hedged request to the next one.
+	DefaultHedgeDelay = 500 * time.Millisecond
+	// DefaultFailureThreshold is the number of consecutive failures after which
+	// the pool stops sending requests to a verifier.
+	DefaultFailureThreshold = 3
+	// DefaultCooldown is how long the pool stops sending requests to an unhealthy verifier.
+	DefaultCooldown = 30 * time.Second
+
+	// latencyWeight is the weight of the latest latency in the moving average of the latency of a verifier.
+	latencyWeight = 0.2
+)
+
+// PoolOption configures a pool of verifiers.
+type PoolOption func(*pool)
+
+// WithHedgeDelay sets how long the pool waits for a verifier to answer
+// before sending a hedged request to the next one.
+func WithHedgeDelay(delay time.Duration) PoolOption {
+	return func(p *pool) {
+		p.hedgeDelay = delay
+	}
+}
+
+// WithCircuitBreaker sets the number of consecutive failures after which the pool
+// stops sending requests to a verifier, and for how long.
+func WithCircuitBreaker(failureThreshold uint, cooldown time.Duration) PoolOption {
+	return func(p *pool) {
+		p.failureThreshold = failureThreshold
+		p.cooldown = cooldown
+	}
+}
+
+// verifierStats are the statistics a pool keeps about one of its verifiers.
+type verifierStats struct {
+	Name string
+	// Latency is the moving average of the time the verifier takes to answer.
+	Latency time.Duration
+	// ConsecutiveErrors is the number of errors since the last answer.
+	ConsecutiveErrors uint
+	// UnhealthyUntil is when the pool sends requests to the verifier again, if it is unhealthy.
+	UnhealthyUntil time.Time
+}
+
+// Healthy tells whether the pool sends requests to the verifier.
+func (s *verifierStats) Healthy(now time.Time) bool {
+	return !now.Before(s.UnhealthyUntil)
+}
+
+type pooledVerifier struct {
+	verifier Verifier
+
+	mu    sync.Mutex
+	stats verifierStats
+}
+
+// record updates the statistics of the verifier with the outcome of a request.
+// Invalid challenges are answers like any other.
+func (v *pooledVerifier) record(latency time.Duration, err error, failureThreshold uint, cooldown time.Duration) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if err != nil && !errors.Is(err, ErrChallengeInvalid) {
+		v.stats.ConsecutiveErrors++
+		if failureThreshold > 0 && v.stats.ConsecutiveErrors >= failureThreshold {
+			v.stats.UnhealthyUntil = time.Now().Add(cooldown)
+		}
+		return
+	}
+	v.stats.ConsecutiveErrors = 0
+	v.stats.UnhealthyUntil = time.Time{}
+	if v.stats.Latency == 0 {
+		v.stats.Latency = latency
+	} else {
+		v.stats.Latency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(v.stats.Latency))
+	}
+}
+
+func (v *pooledVerifier) snapshot() verifierStats {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.stats
+}
+
+// pool gathers many verifiers. It is safe for concurrent use.
+// It sends a challenge to the fastest healthy verifier, and to the next one
+// if it fails or doesn't answer within the hedge delay, until one of them answers.
+// Verifiers failing repeatedly are considered unhealthy and left aside for a while.
+type pool struct {
+	mu       sync.Mutex
+	services []*pooledVerifier
+
+	hedgeDelay       time.Duration
+	failureThreshold uint
+	cooldown         time.Duration
+}
+
+type poolAnswer struct {
+	service *pooledVerifier
+	result  *Result
+	err     error
+}
+
+// candidates orders the verifiers to send a request to: the healthy ones, fastest first.
+// If none is healthy, all of them are candidates, the ones that became unhealthy first first.
+func (p *pool) candidates() []*pooledVerifier {
+	services := p.pooled()
+	now := time.Now()
+	stats := make(map[*pooledVerifier]verifierStats, len(services))
+	var healthy []*pooledVerifier
+	for _, service := range services {
+		stats[service] = service.snapshot()
+		if s := stats[service]; s.Healthy(now) {
+			healthy = append(healthy, service)
+		}
+	}
+	if len(healthy) != 0 {
+		sort.SliceStable(healthy, func(i, j int) bool { return stats[healthy[i]].Latency < stats[healthy[j]].Latency })
+		return healthy
+	}
+
+	all := services
+	sort.SliceStable(all, func(i, j int) bool {
+		return stats[all[i]].UnhealthyUntil.Before(stats[all[j]].UnhealthyUntil)
+	})
+	return all
+}
+
+func (p *pool) Verify(ctx context.Context, challenge, signature []byte) (*Result, error) {
+	logger := logging.FromContext(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	candidates := p.candidates()
+	if len(candidates) == 0 {
+		return nil, ErrCouldNotVerify
+	}
+	answers := make(chan *poolAnswer, len(candidates))
+	send := func() {
+		service := candidates[0]
+		candidates = candidates[1:]
+		go func() {
+			start := time.Now()
+			result, err := service.verifier.Verify(ctx, challenge, signature)
+			// Requests canceled once another verifier answered say nothing about the health of the verifier.
+			if ctx.Err() == nil {
+				service.record(time.Since(start), err, p.failureThreshold, p.cooldown)
+			}
+			answers <- &poolAnswer{service: service, result: result, err: err}
+		}()
+	}
+
+	send()
+	pending := 1
+	hedge := time.NewTimer(p.hedgeDelay)
+	defer hedge.Stop()
+	for pending > 0 {
+		select {
+		case answer := <-answers:
+			pending--
+			if answer.err == nil || errors.Is(answer.err, ErrChallengeInvalid) {
+				return answer.result, answer.err
+			}
+			logger.Debug("verifier could not verify the challenge", zap.String("verifier", answer.service.stats.Name), zap.Error(answer.err))
+			if len(candidates) > 0 {
+				send()
+				pending++
+			}
+		case <-hedge.C:
+			if len(candidates) > 0 {
+				logger.Debug("verifier is slow to answer, sending a hedged request")
+				send()
+				pending++
+				hedge.Reset(p.hedgeDelay)
+			}
+		}
+	}
+	return nil, ErrCouldNotVerify
+}
+
+// pooled returns a copy of the verifiers of the pool.
+func (p *pool) pooled() []*pooledVerifier {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	services := make([]*pooledVerifier, len(p.services))
+	copy(services, p.services)
+	return services
+}
+
+// Add adds a verifier to the pool.
+func (p *pool) Add(service Verifier) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	name := fmt.Sprintf("#%d", len(p.services))
+	if s, ok := service.(fmt.Stringer); ok {
+		name = s.String()
+	}
+	p.services = append(p.services, &pooledVerifier{verifier: service, stats: verifierStats{Name: name}})
+}
+
+// NewPool creates a Verifier sending requests to the healthy `services`.
+// Verifiers are named in logs by their gateway if they have one.
+func NewPool(services []Verifier, opts ...PoolOption) Expandable {
+	p := &pool{
+		hedgeDelay:       DefaultHedgeDelay,
+		failureThreshold: DefaultFailureThreshold,
+		cooldown:         DefaultCooldown,
+	}
+	for _, service := range services {
+		p.Add(service)
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
@@ -0,0 +1,166 @@
+package challenge_verifier_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/spacemeshos/poet/gateway/challenge_verifier"
+)
+
+// counting counts the requests sent to a verifier.
+type counting struct {
+	challenge_verifier.Verifier
+	calls atomic.Int32
+}
+
+func (c *counting) Verify(ctx context.Context, challenge, signature []byte) (*challenge_verifier.Result, error) {
+	c.calls.Add(1)
+	return c.Verifier.Verify(ctx, challenge, signature)
+}
+
+func TestPoolVerifier(t *testing.T) {
+	t.Parallel()
+	challenge := []byte("challenge")
+	signature := []byte("signature")
+	expected := &challenge_verifier.Result{Hash: []byte("hash"), NodeId: []byte("node")}
+
+	t.Run("fails over", func(t *testing.T) {
+		t.Parallel()
+		faulty := &counting{Verifier: answering(nil, challenge_verifier.ErrCouldNotVerify)}
+		healthy := &counting{Verifier: answering(expected, nil)}
+		pool := challenge_verifier.NewPool([]challenge_verifier.Verifier{faulty, healthy}, challenge_verifier.WithHedgeDelay(time.Hour))
+
+		result, err := pool.Verify(context.Background(), challenge, signature)
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+		require.EqualValues(t, 1, faulty.calls.Load())
+		require.EqualValues(t, 1, healthy.calls.Load())
+	})
+	t.Run("invalid challenges are not retried", func(t *testing.T) {
+		t.Parallel()
+		first := &counting{Verifier: answering(nil, challenge_verifier.ErrChallengeInvalid)}
+		second := &counting{Verifier: answering(expected, nil)}
+		pool := challenge_verifier.NewPool([]challenge_verifier.Verifier{first, second}, challenge_verifier.WithHedgeDelay(time.Hour))
+
+		_, err := pool.Verify(context.Background(), challenge, signature)
+		require.ErrorIs(t, err, challenge_verifier.ErrChallengeInvalid)
+		require.Zero(t, second.calls.Load())
+	})
+	t.Run("all fail", func(t *testing.T) {
+		t.Parallel()
+		pool := challenge_verifier.NewPool([]challenge_verifier.Verifier{
+			answering(nil, challenge_verifier.ErrCouldNotVerify),
+			answering(nil, challenge_verifier.ErrCouldNotVerify),
+		})
+		_, err := pool.Verify(context.Background(), challenge, signature)
+		require.ErrorIs(t, err, challenge_verifier.ErrCouldNotVerify)
+	})
+	t.Run("hedges slow requests", func(t *testing.T) {
+		t.Parallel()
+		slow := verifierFunc(func(ctx context.Context, _, _ []byte) (*challenge_verifier.Result, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+		pool := challenge_verifier.NewPool([]challenge_verifier.Verifier{slow, answering(expected, nil)}, challenge_verifier.WithHedgeDelay(10*time.Millisecond))
+
+		result, err := pool.Verify(context.Background(), challenge, signature)
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+	})
+	t.Run("prefers fast verifiers", func(t *testing.T) {
+		t.Parallel()
+		slow := &counting{Verifier: verifierFunc(func(context.Context, []byte, []byte) (*challenge_verifier.Result, error) {
+			time.Sleep(20 * time.Millisecond)
+			return expected, nil
+		})}
+		fast := &counting{Verifier: verifierFunc(func(context.Context, []byte, []byte) (*challenge_verifier.Result, error) {
+			time.Sleep(time.Millisecond)
+			return expected, nil
+		})}
+		pool := challenge_verifier.NewPool([]challenge_verifier.Verifier{slow, fast}, challenge_verifier.WithHedgeDelay(time.Hour))
+
+		// The first request measures the slow verifier, the second one the fast verifier.
+		for i := 0; i < 5; i++ {
+			_, err := pool.Verify(context.Background(), challenge, signature)
+			require.NoError(t, err)
+		}
+		require.EqualValues(t, 1, slow.calls.Load())
+		require.EqualValues(t, 4, fast.calls.Load())
+	})
+	t.Run("breaks the circuit of failing verifiers", func(t *testing.T) {
+		t.Parallel()
+		var failing atomic.Bool
+		failing.Store(true)
+		flaky := &counting{Verifier: verifierFunc(func(context.Context, []byte, []byte) (*challenge_verifier.Result, error) {
+			if failing.Load() {
+				return nil, challenge_verifier.ErrCouldNotVerify
+			}
+			return expected, nil
+		})}
+		healthy := &counting{Verifier: verifierFunc(func(context.Context, []byte, []byte) (*challenge_verifier.Result, error) {
+			// Slower than the flaky verifier once it recovers.
+			time.Sleep(5 * time.Millisecond)
+			return expected, nil
+		})}
+		cooldown := 100 * time.Millisecond
+		pool := challenge_verifier.NewPool(
+			[]challenge_verifier.Verifier{flaky, healthy},
+			challenge_verifier.WithHedgeDelay(time.Hour),
+			challenge_verifier.WithCircuitBreaker(2, cooldown),
+		)
+
+		for i := 0; i < 5; i++ {
+			_, err := pool.Verify(context.Background(), challenge, signature)
+			require.NoError(t, err)
+		}
+		// The flaky verifier is left aside after 2 failures.
+		require.EqualValues(t, 2, flaky.calls.Load())
+		require.EqualValues(t, 5, healthy.calls.Load())
+
+		// It is tried again after the cooldown.
+		failing.Store(false)
+		time.Sleep(cooldown)
+		_, err := pool.Verify(context.Background(), challenge, signature)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, flaky.calls.Load())
+	})
+	t.Run("concurrent use", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		flaky := verifierFunc(func(context.Context, []byte, []byte) (*challenge_verifier.Result, error) {
+			if calls.Add(1)%2 == 0 {
+				return nil, challenge_verifier.ErrCouldNotVerify
+			}
+			return expected, nil
+		})
+		pool := challenge_verifier.NewPool([]challenge_verifier.Verifier{flaky, flaky, answering(expected, nil)}, challenge_verifier.WithHedgeDelay(time.Millisecond))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				result, err := pool.Verify(context.Background(), challenge, signature)
+				require.NoError(t, err)
+				require.Equal(t, expected, result)
+			}()
+		}
+		wg.Wait()
+	})
+	t.Run("added verifiers are used", func(t *testing.T) {
+		t.Parallel()
+		pool := challenge_verifier.NewPool([]challenge_verifier.Verifier{answering(nil, challenge_verifier.ErrCouldNotVerify)}, challenge_verifier.WithHedgeDelay(time.Millisecond))
+		_, err := pool.Verify(context.Background(), challenge, signature)
+		require.ErrorIs(t, err, challenge_verifier.ErrCouldNotVerify)
+
+		pool.Add(answering(expected, nil))
+		result, err := pool.Verify(context.Background(), challenge, signature)
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+	})
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
-	lru "github.com/hashicorp/golang-lru"
 	"github.com/minio/sha256-simd"
 	"go.uber.org/zap"
 
@@ -18,6 +18,7 @@ const MaxBackoff = time.Second * 30
 var (
 	ErrChallengeInvalid = errors.New("challenge is invalid")
 	ErrCouldNotVerify   = errors.New("could not verify the challenge")
+	ErrDisagreement     = errors.New("verifiers disagree on the challenge")
 )
 
 //go:generate mockgen -package mocks -destination mocks/challenge_verifier.go . Verifier
@@ -25,87 +26,150 @@ var (
 type Result struct {
 	Hash   []byte
 	NodeId []byte
+	// Verifiers names the verifiers that verified the challenge, e.g. their gateways.
+	Verifiers []string
+	// VerifiedAt is when the challenge was verified.
+	VerifiedAt time.Time
 }
 
 type Verifier interface {
 	Verify(ctx context.Context, challenge, signature []byte) (*Result, error)
 }
 
-// roundRobin gathers many verifiers.
-// It tries to verify a challenge in a round robin fashion,
-// retrying with the next verifier if the previous one was not
-// able to complete verification.
-type roundRobin struct {
-	services    []Verifier
-	lastUsedSvc int
+// Expandable is a Verifier gathering many verifiers, which accepts new ones while in use.
+type Expandable interface {
+	Verifier
+	Add(Verifier)
 }
 
-func (a *roundRobin) Verify(ctx context.Context, challenge, signature []byte) (*Result, error) {
-	for retries := 0; retries < len(a.services); retries++ {
-		hash, err := a.services[a.lastUsedSvc].Verify(ctx, challenge, signature)
-		if err == nil {
-			return hash, nil
-		}
-		if errors.Is(err, ErrChallengeInvalid) {
-			return nil, err
-		}
-		a.lastUsedSvc = (a.lastUsedSvc + 1) % len(a.services)
-	}
+// quorum gathers many verifiers.
+// It asks all of them to verify a challenge at once and requires
+// `threshold` identical answers, either the same result or the challenge being invalid.
+type quorum struct {
+	mu       sync.Mutex
+	services []Verifier
 
-	return nil, ErrCouldNotVerify
+	threshold int
+}
+
+type quorumAnswer struct {
+	service int
+	result  *Result
+	err     error
 }
 
-func NewRoundRobin(services []Verifier) Verifier {
-	return &roundRobin{
-		services: services,
+// key identifies identical answers.
+func (a *quorumAnswer) key() string {
+	if a.result == nil {
+		return "invalid"
 	}
+	return fmt.Sprintf("%x/%x", a.result.Hash, a.result.NodeId)
 }
 
-// caching implements caching layer on top of
-// its ChallengeVerifier.
-type caching struct {
-	cache    *lru.Cache
-	verifier Verifier
+func (q *quorum) Verify(ctx context.Context, challenge, signature []byte) (*Result, error) {
+	logger := logging.FromContext(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	q.mu.Lock()
+	services := make([]Verifier, len(q.services))
+	copy(services, q.services)
+	q.mu.Unlock()
+	if len(services) < q.threshold {
+		return nil, fmt.Errorf("%w: %d verifiers available, %d identical answers required", ErrCouldNotVerify, len(services), q.threshold)
+	}
+
+	answers := make(chan *quorumAnswer, len(services))
+	for i, service := range services {
+		i, service := i, service
+		go func() {
+			result, err := service.Verify(ctx, challenge, signature)
+			answers <- &quorumAnswer{service: i, result: result, err: err}
+		}()
+	}
+
+	votes := make(map[string][]*quorumAnswer)
+	for range services {
+		answer := <-answers
+		if answer.err != nil && !errors.Is(answer.err, ErrChallengeInvalid) {
+			logger.Debug("verifier could not verify the challenge", zap.String("verifier", name(services, answer.service)), zap.Error(answer.err))
+			continue
+		}
+		key := answer.key()
+		votes[key] = append(votes[key], answer)
+		if len(votes[key]) < q.threshold {
+			continue
+		}
+		if len(votes) > 1 {
+			logger.Warn("verifiers disagree on the challenge, the quorum is reached", zap.Any("answers", describeVotes(services, votes)))
+		}
+		if answer.result == nil {
+			return nil, ErrChallengeInvalid
+		}
+		result := *answer.result
+		result.Verifiers = nil
+		for _, agreeing := range votes[key] {
+			result.Verifiers = append(result.Verifiers, agreeing.result.Verifiers...)
+		}
+		return &result, nil
+	}
+
+	if len(votes) > 1 {
+		logger.Warn("verifiers disagree on the challenge", zap.Any("answers", describeVotes(services, votes)))
+		return nil, fmt.Errorf("%w: %d different answers, %d identical required", ErrDisagreement, len(votes), q.threshold)
+	}
+	return nil, ErrCouldNotVerify
 }
 
-type challengeVerifierResult struct {
-	*Result
-	err error
+// Add adds a verifier to the quorum. The threshold is unchanged.
+func (q *quorum) Add(service Verifier) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.services = append(q.services, service)
 }
 
-func (a *caching) Verify(ctx context.Context, challenge, signature []byte) (*Result, error) {
-	var challengeHash [sha256.Size]byte
-	hasher := sha256.New()
-	hasher.Write(challenge)
-	hasher.Write(signature)
-	hasher.Sum(challengeHash[:0])
-
-	logger := logging.FromContext(ctx).With(zap.Binary("challenge", challengeHash[:]))
-	if result, ok := a.cache.Get(challengeHash); ok {
-		logger.Debug("retrieved challenge verifier result from the cache")
-		// SAFETY: type assertion will never panic as we insert only `*challengeVerifierResult` values.
-		result := result.(*challengeVerifierResult)
-		return result.Result, result.err
+// name names a verifier in logs, by its gateway if it has one.
+func name(services []Verifier, service int) string {
+	if s, ok := services[service].(fmt.Stringer); ok {
+		return s.String()
 	}
+	return fmt.Sprintf("#%d", service)
+}
 
-	result, err := a.verifier.Verify(ctx, challenge, signature)
-	if err == nil || errors.Is(err, ErrChallengeInvalid) {
-		a.cache.Add(challengeHash, &challengeVerifierResult{Result: result, err: err})
+// describeVotes lists the verifiers that gave each answer.
+func describeVotes(services []Verifier, votes map[string][]*quorumAnswer) map[string][]string {
+	out := make(map[string][]string, len(votes))
+	for key, answers := range votes {
+		for _, answer := range answers {
+			out[key] = append(out[key], name(services, answer.service))
+		}
 	}
-	return result, err
+	return out
 }
 
-func NewCaching(size int, verifier Verifier) (Verifier, error) {
-	cache, err := lru.New(size)
-	if err != nil {
-		return nil, err
+// NewQuorum creates a Verifier requiring `threshold` of `services` to give the same answer.
+// There may be less than `threshold` services until enough of them are added,
+// the challenges cannot be verified in the meantime.
+func NewQuorum(services []Verifier, threshold uint) (Expandable, error) {
+	if threshold == 0 {
+		return nil, errors.New("quorum threshold must be at least 1")
 	}
-	return &caching{
-		cache:    cache,
-		verifier: verifier,
+	return &quorum{
+		services:  services,
+		threshold: int(threshold),
 	}, nil
 }
 
+// cacheKey identifies the verification of a challenge in caches.
+func cacheKey(challenge, signature []byte) [sha256.Size]byte {
+	var key [sha256.Size]byte
+	hasher := sha256.New()
+	hasher.Write(challenge)
+	hasher.Write(signature)
+	hasher.Sum(key[:0])
+	return key
+}
+
 type retrying struct {
 	backoffBase       time.Duration
 	backoffMultiplier float64
@@ -119,12 +183,15 @@ func (v *retrying) Verify(ctx context.Context, challenge, signature []byte) (*Re
 	<-timer.C
 	delay := v.backoffBase
 
+	var lastErr error
 	for retry := uint(0); retry < v.maxRetries; retry++ {
-		if hash, err := v.verifier.Verify(ctx, challenge, signature); err == nil {
+		hash, err := v.verifier.Verify(ctx, challenge, signature)
+		if err == nil {
 			return hash, nil
 		} else if errors.Is(err, ErrChallengeInvalid) {
 			return nil, err
 		}
+		lastErr = err
 		timer.Reset(delay)
 		logger.Sugar().Infof("Retrying for %d time, waiting %v", retry+1, delay)
 		select {
@@ -141,6 +208,9 @@ func (v *retrying) Verify(ctx context.Context, challenge, signature []byte) (*Re
 			delay = MaxBackoff
 		}
 	}
+	if errors.Is(lastErr, ErrDisagreement) {
+		return nil, lastErr
+	}
 	return nil, ErrCouldNotVerify
 }
 
@@ -12,106 +12,121 @@ import (
 	"github.com/spacemeshos/poet/gateway/challenge_verifier/mocks"
 )
 
-func TestRoundRobinVerifier(t *testing.T) {
-	t.Parallel()
-	ctrl := gomock.NewController(t)
-
-	challenge := []byte("challenge")
-	signature := []byte("signature")
-	expected := &challenge_verifier.Result{Hash: []byte("hash")}
-
-	faultyVerifier := mocks.NewMockVerifier(ctrl)
-	faultyVerifier.EXPECT().Verify(gomock.Any(), challenge, signature).Return(nil, challenge_verifier.ErrCouldNotVerify)
-	verifier := mocks.NewMockVerifier(ctrl)
-	verifier.EXPECT().Verify(gomock.Any(), challenge, signature).Times(2).Return(expected, nil)
+type verifierFunc func(ctx context.Context, challenge, signature []byte) (*challenge_verifier.Result, error)
 
-	rrVerifier := challenge_verifier.NewRoundRobin([]challenge_verifier.Verifier{faultyVerifier, verifier})
-
-	result, err := rrVerifier.Verify(context.Background(), challenge, signature)
-	require.NoError(t, err)
-	require.EqualValues(t, expected, result)
+func (f verifierFunc) Verify(ctx context.Context, challenge, signature []byte) (*challenge_verifier.Result, error) {
+	return f(ctx, challenge, signature)
+}
 
-	// Second Get should query `verifier` second time.
-	result, err = rrVerifier.Verify(context.Background(), challenge, signature)
-	require.NoError(t, err)
-	require.EqualValues(t, expected, result)
+func answering(result *challenge_verifier.Result, err error) challenge_verifier.Verifier {
+	return verifierFunc(func(context.Context, []byte, []byte) (*challenge_verifier.Result, error) {
+		return result, err
+	})
 }
 
-func TestCachedProvider(t *testing.T) {
+func TestQuorumVerifier(t *testing.T) {
 	t.Parallel()
 	challenge := []byte("challenge")
-	challenge2 := []byte("challenge2")
 	signature := []byte("signature")
-	expected := &challenge_verifier.Result{Hash: []byte("hash")}
-
-	t.Run("caching", func(t *testing.T) {
+	expected := &challenge_verifier.Result{Hash: []byte("hash"), NodeId: []byte("node")}
+	other := &challenge_verifier.Result{Hash: []byte("other hash"), NodeId: []byte("node")}
+
+	for _, tc := range []struct {
+		name     string
+		answers  []challenge_verifier.Verifier
+		expected *challenge_verifier.Result
+		err      error
+	}{
+		{
+			name:     "agreement",
+			answers:  []challenge_verifier.Verifier{answering(expected, nil), answering(expected, nil), answering(expected, nil)},
+			expected: expected,
+		},
+		{
+			name:     "quorum despite a disagreement",
+			answers:  []challenge_verifier.Verifier{answering(other, nil), answering(expected, nil), answering(expected, nil)},
+			expected: expected,
+		},
+		{
+			name:     "quorum despite a failure",
+			answers:  []challenge_verifier.Verifier{answering(nil, challenge_verifier.ErrCouldNotVerify), answering(expected, nil), answering(expected, nil)},
+			expected: expected,
+		},
+		{
+			name:    "invalid",
+			answers: []challenge_verifier.Verifier{answering(expected, nil), answering(nil, challenge_verifier.ErrChallengeInvalid), answering(nil, challenge_verifier.ErrChallengeInvalid)},
+			err:     challenge_verifier.ErrChallengeInvalid,
+		},
+		{
+			name:    "disagreement",
+			answers: []challenge_verifier.Verifier{answering(other, nil), answering(expected, nil), answering(nil, challenge_verifier.ErrChallengeInvalid)},
+			err:     challenge_verifier.ErrDisagreement,
+		},
+		{
+			name:    "no quorum",
+			answers: []challenge_verifier.Verifier{answering(expected, nil), answering(nil, challenge_verifier.ErrCouldNotVerify), answering(nil, challenge_verifier.ErrCouldNotVerify)},
+			err:     challenge_verifier.ErrCouldNotVerify,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			verifier, err := challenge_verifier.NewQuorum(tc.answers, 2)
+			require.NoError(t, err)
+
+			result, err := verifier.Verify(context.Background(), challenge, signature)
+			require.ErrorIs(t, err, tc.err)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+
+	t.Run("invalid threshold", func(t *testing.T) {
 		t.Parallel()
-		ctrl := gomock.NewController(t)
-		verifier := mocks.NewMockVerifier(ctrl)
-		verifier.EXPECT().Verify(gomock.Any(), challenge, signature).Return(expected, nil)
-
-		rrVerifier, err := challenge_verifier.NewCaching(1, verifier)
-		require.NoError(t, err)
-
-		result, err := rrVerifier.Verify(context.Background(), challenge, signature)
-		require.NoError(t, err)
-		require.EqualValues(t, expected, result)
-
-		result, err = rrVerifier.Verify(context.Background(), challenge, signature)
-		require.NoError(t, err)
-		require.EqualValues(t, expected, result)
+		verifiers := []challenge_verifier.Verifier{answering(expected, nil), answering(expected, nil)}
+		_, err := challenge_verifier.NewQuorum(verifiers, 0)
+		require.Error(t, err)
 	})
-	t.Run("eviction", func(t *testing.T) {
+	t.Run("waits for enough verifiers", func(t *testing.T) {
 		t.Parallel()
-		ctrl := gomock.NewController(t)
-		expected := &challenge_verifier.Result{Hash: []byte("hash")}
-		verifier := mocks.NewMockVerifier(ctrl)
-		verifier.EXPECT().Verify(gomock.Any(), challenge, signature).Times(2).Return(expected, nil)
-		verifier.EXPECT().Verify(gomock.Any(), challenge2, signature).Return(expected, nil)
-
-		cachingVerifier, err := challenge_verifier.NewCaching(1, verifier)
-		require.NoError(t, err)
-
-		result, err := cachingVerifier.Verify(context.Background(), challenge, signature)
+		verifier, err := challenge_verifier.NewQuorum([]challenge_verifier.Verifier{answering(expected, nil)}, 2)
 		require.NoError(t, err)
-		require.EqualValues(t, expected, result)
+		_, err = verifier.Verify(context.Background(), challenge, signature)
+		require.ErrorIs(t, err, challenge_verifier.ErrCouldNotVerify)
 
-		result, err = cachingVerifier.Verify(context.Background(), challenge, signature)
+		verifier.Add(answering(expected, nil))
+		result, err := verifier.Verify(context.Background(), challenge, signature)
 		require.NoError(t, err)
-		require.EqualValues(t, expected, result)
-
-		// This Get should evict `test`
-		result, err = cachingVerifier.Verify(context.Background(), challenge2, signature)
+		require.Equal(t, expected, result)
+	})
+	t.Run("names the verifiers that agree", func(t *testing.T) {
+		t.Parallel()
+		from := func(name string) *challenge_verifier.Result {
+			return &challenge_verifier.Result{Hash: expected.Hash, NodeId: expected.NodeId, Verifiers: []string{name}}
+		}
+		verifiers := []challenge_verifier.Verifier{
+			answering(from("a"), nil),
+			answering(nil, challenge_verifier.ErrCouldNotVerify),
+			answering(from("b"), nil),
+		}
+		verifier, err := challenge_verifier.NewQuorum(verifiers, 2)
 		require.NoError(t, err)
-		require.EqualValues(t, expected, result)
 
-		result, err = cachingVerifier.Verify(context.Background(), challenge, signature)
+		result, err := verifier.Verify(context.Background(), challenge, signature)
 		require.NoError(t, err)
-		require.EqualValues(t, expected, result)
+		require.ElementsMatch(t, []string{"a", "b"}, result.Verifiers)
 	})
-	t.Run("doesn't cache when verification failed", func(t *testing.T) {
+	t.Run("added verifiers vote", func(t *testing.T) {
 		t.Parallel()
-		ctrl := gomock.NewController(t)
-		expected := &challenge_verifier.Result{Hash: []byte("hash")}
-		verifier := mocks.NewMockVerifier(ctrl)
-		verifier.EXPECT().Verify(gomock.Any(), challenge, signature).Times(2).Return(nil, challenge_verifier.ErrCouldNotVerify)
-		verifier.EXPECT().Verify(gomock.Any(), challenge, signature).Return(expected, nil)
-
-		cachingVerifier, err := challenge_verifier.NewCaching(1, verifier)
+		verifiers := []challenge_verifier.Verifier{answering(expected, nil), answering(nil, challenge_verifier.ErrCouldNotVerify)}
+		verifier, err := challenge_verifier.NewQuorum(verifiers, 2)
 		require.NoError(t, err)
+		_, err = verifier.Verify(context.Background(), challenge, signature)
+		require.ErrorIs(t, err, challenge_verifier.ErrCouldNotVerify)
 
-		_, err = cachingVerifier.Verify(context.Background(), challenge, signature)
-		require.Error(t, err)
-		// should not hit cache
-		_, err = cachingVerifier.Verify(context.Background(), challenge, signature)
-		require.Error(t, err)
-		result, err := cachingVerifier.Verify(context.Background(), challenge, signature)
-		require.NoError(t, err)
-		require.EqualValues(t, expected, result)
-		// should hit cache
-		result, err = cachingVerifier.Verify(context.Background(), challenge, signature)
+		verifier.Add(answering(expected, nil))
+		result, err := verifier.Verify(context.Background(), challenge, signature)
 		require.NoError(t, err)
-		require.EqualValues(t, expected, result)
+		require.Equal(t, expected, result)
 	})
 }
 
@@ -141,6 +156,15 @@ func TestRetryingProvider(t *testing.T) {
 		_, err := retryingVerifier.Verify(context.Background(), challenge, signature)
 		require.ErrorIs(t, err, challenge_verifier.ErrCouldNotVerify)
 	})
+	t.Run("reports disagreements", func(t *testing.T) {
+		t.Parallel()
+		verifier := mocks.NewMockVerifier(gomock.NewController(t))
+		verifier.EXPECT().Verify(gomock.Any(), challenge, signature).Times(3).Return(nil, challenge_verifier.ErrDisagreement)
+		retryingVerifier := challenge_verifier.NewRetrying(verifier, 3, time.Nanosecond, 1)
+
+		_, err := retryingVerifier.Verify(context.Background(), challenge, signature)
+		require.ErrorIs(t, err, challenge_verifier.ErrDisagreement)
+	})
 	t.Run("doesn't retry when the challenge is invalid", func(t *testing.T) {
 		t.Parallel()
 		verifier := mocks.NewMockVerifier(gomock.NewController(t))
@@ -3,25 +3,167 @@ package gateway
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 
 	"github.com/spacemeshos/poet/logging"
 )
 
+const (
+	// reconnectBackoffBase and reconnectMaxBackoff bound the delay between two attempts
+	// to connect to a gateway that is unreachable.
+	reconnectBackoffBase = time.Second
+	reconnectMaxBackoff  = time.Second * 30
+	// reconnectTimeout is the timeout of an attempt to connect to a gateway.
+	reconnectTimeout = time.Second * 10
+
+	// latencyWeight is the weight of the latest latency in the moving average of the latency of a gateway.
+	latencyWeight = 0.2
+)
+
+// Status is the connectivity status of a gateway.
+type Status struct {
+	Address   string
+	Connected bool
+	// State is the state of the connection, if connected.
+	State string
+	// Latency is the moving average of the latency of the requests to the gateway.
+	Latency time.Duration
+	// LastError is the error of the last failed attempt to connect or request.
+	LastError     string
+	LastErrorTime time.Time
+}
+
+// ManagerStatus is the connectivity status of the gateways of a manager.
+type ManagerStatus struct {
+	Gateways []Status
+	// Ready is whether at least Required gateways are connected.
+	Ready    bool
+	Required uint
+}
+
+// gtw is a gateway the manager is connected or keeps connecting to.
+type gtw struct {
+	address string
+
+	mu     sync.Mutex
+	conn   *grpc.ClientConn
+	status Status
+}
+
+func (g *gtw) recordError(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.status.LastError = err.Error()
+	g.status.LastErrorTime = time.Now()
+}
+
+// interceptor tracks the latency and the errors of the requests to the gateway.
+func (g *gtw) interceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	latency := time.Since(start)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.status.Latency == 0 {
+		g.status.Latency = latency
+	} else {
+		g.status.Latency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(g.status.Latency))
+	}
+	if err != nil {
+		g.status.LastError = err.Error()
+		g.status.LastErrorTime = time.Now()
+	}
+	return err
+}
+
+// ManagerOption configures a Manager.
+type ManagerOption func(*Manager)
+
+// WithTransportCredentials sets the credentials of the connections to the gateways, e.g. TLS.
+// The connections are insecure by default.
+func WithTransportCredentials(creds credentials.TransportCredentials) ManagerOption {
+	return func(m *Manager) {
+		m.creds = creds
+	}
+}
+
 // Manager aggregates GRPC connections to gateways.
+// It keeps connecting in the background to the gateways that were unreachable.
 // Its Close() must be called when the connections are no longer needed.
 type Manager struct {
-	connections []*grpc.ClientConn
+	mu          sync.Mutex
+	gateways    []*gtw
+	minConns    uint
+	subscribers []func(*grpc.ClientConn)
+	creds       credentials.TransportCredentials
+
+	stop context.CancelFunc
+	eg   sync.WaitGroup
 }
 
+// Connections returns the connections to the gateways connected so far.
 func (m *Manager) Connections() []*grpc.ClientConn {
-	return m.connections
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.connections()
+}
+
+func (m *Manager) connections() []*grpc.ClientConn {
+	var connections []*grpc.ClientConn
+	for _, g := range m.gateways {
+		if conn := g.connection(); conn != nil {
+			connections = append(connections, conn)
+		}
+	}
+	return connections
+}
+
+func (g *gtw) connection() *grpc.ClientConn {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.conn
+}
+
+// Subscribe returns the connections to the gateways connected so far,
+// and calls `onConnect` with the connection of every gateway connecting from now on.
+func (m *Manager) Subscribe(onConnect func(*grpc.ClientConn)) []*grpc.ClientConn {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subscribers = append(m.subscribers, onConnect)
+	return m.connections()
+}
+
+// Status returns the connectivity status of the gateways.
+func (m *Manager) Status() ManagerStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	status := ManagerStatus{
+		Gateways: make([]Status, 0, len(m.gateways)),
+		Required: m.minConns,
+	}
+	connected := uint(0)
+	for _, g := range m.gateways {
+		g.mu.Lock()
+		gtwStatus := g.status
+		if g.conn != nil {
+			gtwStatus.Connected = true
+			gtwStatus.State = g.conn.GetState().String()
+			connected++
+		}
+		g.mu.Unlock()
+		status.Gateways = append(status.Gateways, gtwStatus)
+	}
+	status.Ready = connected > 0 && connected >= m.minConns
+	return status
 }
 
 func closeConnections(connections []*grpc.ClientConn) error {
@@ -34,33 +176,91 @@ func closeConnections(connections []*grpc.ClientConn) error {
 	return result.ErrorOrNil()
 }
 
+// Close stops connecting to the unreachable gateways and closes the connections.
 func (m *Manager) Close() error {
-	return closeConnections(m.connections)
+	if m.stop != nil {
+		m.stop()
+	}
+	m.eg.Wait()
+	return closeConnections(m.Connections())
 }
 
-// NewManager creates a gateway manager connected to `gateways`.
+// NewManager creates a gateway manager connecting to `gateways` until `ctx` is done,
+// and keeps connecting to the unreachable ones in the background.
+// Its Status() is ready once at least `minSuccesfulConns` gateways, and at least one, are connected.
 // Close() must be called when the connections are no longer needed.
-func NewManager(ctx context.Context, gateways []string, minSuccesfulConns uint) (*Manager, error) {
-	connections, errs := connect(ctx, gateways)
-	if len(connections) < int(minSuccesfulConns) {
-		errs = multierror.Append(errs, closeConnections(connections))
-		return nil, fmt.Errorf("not enough gtw connections (%d/%d): %w", len(connections), minSuccesfulConns, errs)
+func NewManager(ctx context.Context, gateways []string, minSuccesfulConns uint, opts ...ManagerOption) *Manager {
+	if minSuccesfulConns == 0 {
+		minSuccesfulConns = 1
+	}
+	m := &Manager{creds: insecure.NewCredentials(), minConns: minSuccesfulConns}
+	for _, opt := range opts {
+		opt(m)
+	}
+	for _, address := range gateways {
+		m.gateways = append(m.gateways, &gtw{address: address, status: Status{Address: address}})
+	}
+	if connected, err := connect(ctx, m.gateways, m.creds); connected < int(minSuccesfulConns) {
+		logging.FromContext(ctx).Warn("not enough gateways connected, reconnecting in the background",
+			zap.Int("connected", connected), zap.Uint("required", minSuccesfulConns), zap.Error(err))
 	}
 
-	return &Manager{connections: connections}, nil
+	bgCtx, stop := context.WithCancel(logging.NewContext(context.Background(), logging.FromContext(ctx)))
+	m.stop = stop
+	for _, g := range m.gateways {
+		if g.connection() == nil {
+			g := g
+			m.eg.Add(1)
+			go func() {
+				defer m.eg.Done()
+				m.reconnect(bgCtx, g)
+			}()
+		}
+	}
+	return m
 }
 
-// Connect tries to connect to gateways at provided addresses.
-// Returns list of connections and errors for connection failures.
-func connect(ctx context.Context, gateways []string) ([]*grpc.ClientConn, error) {
-	logger := logging.FromContext(ctx)
-	logger.Info("Attempting to connect to Spacemesh gateway nodes", zap.Strings("gateways", gateways))
-	connections := make([]*grpc.ClientConn, 0, len(gateways))
+// reconnect keeps connecting to the gateway with an exponential backoff until it succeeds or `ctx` is canceled.
+func (m *Manager) reconnect(ctx context.Context, g *gtw) {
+	logger := logging.FromContext(ctx).With(zap.String("gateway", g.address))
+	delay := reconnectBackoffBase
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
 
-	connsChan := make(chan *grpc.ClientConn, len(gateways))
+		dialCtx, cancel := context.WithTimeout(ctx, reconnectTimeout)
+		conn, err := dial(dialCtx, g, m.creds)
+		cancel()
+		if err != nil {
+			logger.Debug("failed to reconnect to gateway", zap.Error(err))
+			g.recordError(err)
+			delay *= 2
+			if delay > reconnectMaxBackoff {
+				delay = reconnectMaxBackoff
+			}
+			continue
+		}
 
+		logger.Info("Successfully reconnected to gateway node")
+		m.mu.Lock()
+		g.mu.Lock()
+		g.conn = conn
+		g.mu.Unlock()
+		subscribers := append([]func(*grpc.ClientConn){}, m.subscribers...)
+		m.mu.Unlock()
+		for _, onConnect := range subscribers {
+			onConnect(conn)
+		}
+		return
+	}
+}
+
+func dial(ctx context.Context, g *gtw, creds credentials.TransportCredentials) (*grpc.ClientConn, error) {
 	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 		grpc.WithBlock(),
 		// XXX: this is done to prevent routers from cleaning up our connections (e.g aws load balances..)
 		// TODO: these parameters work for now but we might need to revisit or add them as configuration
@@ -70,28 +270,46 @@ func connect(ctx context.Context, gateways []string) ([]*grpc.ClientConn, error)
 			Timeout:             time.Minute * 3,
 			PermitWithoutStream: true,
 		}),
+		grpc.WithChainUnaryInterceptor(g.interceptor),
+	}
+	conn, err := grpc.DialContext(ctx, g.address, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to gateway grpc server %s (%w)", g.address, err)
 	}
+	return conn, nil
+}
+
+// connect tries to connect to the gateways.
+// Returns the number of gateways connected and errors for connection failures.
+func connect(ctx context.Context, gateways []*gtw, creds credentials.TransportCredentials) (int, error) {
+	logger := logging.FromContext(ctx)
+	addresses := make([]string, 0, len(gateways))
+	for _, g := range gateways {
+		addresses = append(addresses, g.address)
+	}
+	logger.Info("Attempting to connect to Spacemesh gateway nodes", zap.Strings("gateways", addresses))
 
+	var mu sync.Mutex
+	connected := 0
 	var eg multierror.Group
-	for _, target := range gateways {
-		target := target
+	for _, g := range gateways {
+		g := g
 		eg.Go(func() error {
-			conn, err := grpc.DialContext(ctx, target, opts...)
+			conn, err := dial(ctx, g, creds)
 			if err != nil {
-				return fmt.Errorf("failed to connect to gateway grpc server %s (%w)", target, err)
+				g.recordError(err)
+				return err
 			}
 			logger.Info("Successfully connected to gateway node", zap.String("target", conn.Target()))
-			connsChan <- conn
+			g.mu.Lock()
+			g.conn = conn
+			g.mu.Unlock()
+			mu.Lock()
+			connected++
+			mu.Unlock()
 			return nil
 		})
 	}
 
-	err := eg.Wait()
-	close(connsChan)
-
-	for conn := range connsChan {
-		connections = append(connections, conn)
-	}
-
-	return connections, err
+	return connected, eg.Wait().ErrorOrNil()
 }
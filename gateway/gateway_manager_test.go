@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 )
 
 func TestConnecting(t *testing.T) {
@@ -22,17 +23,60 @@ func TestConnecting(t *testing.T) {
 		t.Parallel()
 		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
 		defer cancel()
-		mgr, err := NewManager(ctx, []string{gtw.Target(), "wrong-address"}, 1)
-		req.NoError(err)
+		mgr := NewManager(ctx, []string{gtw.Target(), "wrong-address"}, 1)
 		t.Cleanup(func() { require.NoError(t, mgr.Close()) })
 		req.Len(mgr.Connections(), 1)
+		status := mgr.Status()
+		req.True(status.Ready)
+		req.Len(status.Gateways, 2)
 	})
-	t.Run("min successful not connections met", func(t *testing.T) {
+	t.Run("min successful connections not met", func(t *testing.T) {
 		t.Parallel()
 		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
 		defer cancel()
-		mgr, err := NewManager(ctx, []string{gtw.Target(), "wrong-address"}, 2)
-		req.Error(err)
-		req.Nil(mgr)
+		mgr := NewManager(ctx, []string{gtw.Target(), "wrong-address"}, 2)
+		t.Cleanup(func() { require.NoError(t, mgr.Close()) })
+		req.Len(mgr.Connections(), 1)
+		status := mgr.Status()
+		req.False(status.Ready)
+		req.EqualValues(2, status.Required)
 	})
 }
+
+func TestReconnecting(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	// The gateway listens but doesn't serve yet, so connecting to it times out.
+	gtw := NewMockGrpcServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	mgr := NewManager(ctx, []string{gtw.Target()}, 1)
+	t.Cleanup(func() { require.NoError(t, mgr.Close()) })
+	connected := make(chan *grpc.ClientConn, 1)
+	req.Empty(mgr.Subscribe(func(conn *grpc.ClientConn) { connected <- conn }))
+
+	status := mgr.Status()
+	req.False(status.Ready)
+	req.Len(status.Gateways, 1)
+	req.Equal(gtw.Target(), status.Gateways[0].Address)
+	req.False(status.Gateways[0].Connected)
+	req.NotEmpty(status.Gateways[0].LastError)
+	req.False(status.Gateways[0].LastErrorTime.IsZero())
+
+	var eg errgroup.Group
+	eg.Go(gtw.Serve)
+	t.Cleanup(func() { require.NoError(t, eg.Wait()) })
+	t.Cleanup(gtw.Stop)
+
+	select {
+	case conn := <-connected:
+		req.Equal([]*grpc.ClientConn{conn}, mgr.Connections())
+	case <-time.After(time.Second * 10):
+		req.Fail("timed out waiting for the manager to reconnect")
+	}
+	status = mgr.Status()
+	req.True(status.Ready)
+	req.Len(status.Gateways, 1)
+	req.True(status.Gateways[0].Connected)
+}
@@ -4,7 +4,7 @@ import (
 	"github.com/minio/sha256-simd"
 )
 
-// LabelHashNestingDepth is the number of recursive hashes per label.
+// LabelHashNestingDepth is the default number of recursive hashes per label.
 const LabelHashNestingDepth = 100
 
 // GenMerkleHashFunc generates Merkle hash functions salted with a challenge. The challenge is prepended to the
@@ -13,6 +13,10 @@ const LabelHashNestingDepth = 100
 // ⚠️ The resulting function is NOT thread-safe, however different generated instances are independent.
 // The code is optimized for performance and memory allocations.
 func GenMerkleHashFunc(challenge []byte) func(lChild, rChild []byte) []byte {
+	return genMerkleHashFunc(sha256.Sum256, challenge)
+}
+
+func genMerkleHashFunc(sum func(data []byte) [32]byte, challenge []byte) func(lChild, rChild []byte) []byte {
 	var buffer []byte
 	return func(lChild, rChild []byte) []byte {
 		size := len(challenge) + len(lChild) + len(rChild)
@@ -23,19 +27,28 @@ func GenMerkleHashFunc(challenge []byte) func(lChild, rChild []byte) []byte {
 		copy(buffer[len(challenge):], lChild)
 		copy(buffer[len(challenge)+len(lChild):], rChild)
 
-		result := sha256.Sum256(buffer[:size])
+		result := sum(buffer[:size])
 		return result[:]
 	}
 }
 
 // GenLabelHashFunc generates hash functions for computing labels. The challenge is prepended to the data and the result
-// is hashed using Sha256. TODO: use nested hashes based on a difficulty param.
+// is hashed using Sha256, LabelHashNestingDepth times.
 func GenLabelHashFunc(challenge []byte) func(data []byte) []byte {
+	return genLabelHashFunc(sha256.Sum256, challenge, LabelHashNestingDepth)
+}
+
+// GenLabelHashFuncWithDifficulty is like GenLabelHashFunc, with `difficulty` recursive hashes per label.
+func GenLabelHashFuncWithDifficulty(challenge []byte, difficulty uint32) func(data []byte) []byte {
+	return genLabelHashFunc(sha256.Sum256, challenge, difficulty)
+}
+
+func genLabelHashFunc(sum func(data []byte) [32]byte, challenge []byte, difficulty uint32) func(data []byte) []byte {
 	return func(data []byte) []byte {
 		message := append(challenge, data...)
 		var res [32]byte
-		for i := 0; i < LabelHashNestingDepth; i++ {
-			res = sha256.Sum256(message)
+		for i := uint32(0); i < difficulty; i++ {
+			res = sum(message)
 			message = res[:]
 		}
 		return message
@@ -1,6 +1,7 @@
 package hash
 
 import (
+	"crypto/sha256"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -22,6 +23,23 @@ func TestGenLabelHashFunc(t *testing.T) {
 	r.NotEqual(GenLabelHashFunc(aChallenge)(data), GenLabelHashFunc(aChallenge)(other))
 }
 
+func TestGenLabelHashFuncWithDifficulty(t *testing.T) {
+	r := require.New(t)
+
+	challenge, data := []byte("a"), []byte("data")
+
+	// default difficulty -> same hash as GenLabelHashFunc
+	r.Equal(GenLabelHashFunc(challenge)(data), GenLabelHashFuncWithDifficulty(challenge, LabelHashNestingDepth)(data))
+
+	// different difficulty -> different hash
+	r.NotEqual(GenLabelHashFuncWithDifficulty(challenge, 1)(data), GenLabelHashFuncWithDifficulty(challenge, 2)(data))
+
+	// one nested hash per level of difficulty
+	once := sha256.Sum256(append(challenge, data...))
+	twice := sha256.Sum256(once[:])
+	r.Equal(twice[:], GenLabelHashFuncWithDifficulty(challenge, 2)(data))
+}
+
 func TestGenMerkleHashFunc(t *testing.T) {
 	r := require.New(t)
 
@@ -37,3 +55,29 @@ func TestGenMerkleHashFunc(t *testing.T) {
 	// different children (e.g. different order) -> different hash
 	r.NotEqual(GenMerkleHashFunc(aChallenge)(lChild, rChild), GenMerkleHashFunc(aChallenge)(rChild, lChild))
 }
+
+func TestSuiteByName(t *testing.T) {
+	r := require.New(t)
+
+	for _, name := range SuiteNames() {
+		suite, err := SuiteByName(name)
+		r.NoError(err)
+		r.Equal(name, suite.Name)
+	}
+
+	// the empty name stands for the suite of the proofs that predate hash suites
+	suite, err := SuiteByName("")
+	r.NoError(err)
+	r.Equal(SHA256, suite.Name)
+	challenge, data := []byte("a"), []byte("data")
+	r.Equal(GenLabelHashFunc(challenge)(data), suite.LabelHash(challenge, 0)(data))
+
+	// different suites -> different hashes
+	sha3, err := SuiteByName(SHA3)
+	r.NoError(err)
+	r.NotEqual(suite.LabelHash(challenge, 0)(data), sha3.LabelHash(challenge, 0)(data))
+	r.NotEqual(suite.MerkleHash(challenge)(data, data), sha3.MerkleHash(challenge)(data, data))
+
+	_, err = SuiteByName("md5")
+	r.ErrorIs(err, ErrUnknownSuite)
+}
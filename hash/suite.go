@@ -0,0 +1,84 @@
+package hash
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/spacemeshos/poet/shared"
+)
+
+// Names of the supported hash suites.
+const (
+	SHA256 = "sha256"
+	SHA3   = "sha3-256"
+)
+
+var ErrUnknownSuite = errors.New("unknown hash suite")
+
+// Suite is a named set of the hash functions used to generate and verify proofs.
+type Suite struct {
+	Name string
+	// LabelHash generates the hash functions computing the labels, salted with a challenge,
+	// with `difficulty` recursive hashes per label.
+	// A zero difficulty stands for LabelHashNestingDepth, the difficulty of the proofs that predate configurable ones.
+	LabelHash func(challenge []byte, difficulty uint32) func(data []byte) []byte
+	// MerkleHash generates the hash functions of the Merkle tree nodes, salted with a challenge.
+	MerkleHash func(challenge []byte) func(lChild, rChild []byte) []byte
+	// FiatShamir derives the indices of the leaves included in a proof from the Merkle root.
+	FiatShamir shared.FiatShamirFunc
+}
+
+var suites = map[string]*Suite{
+	SHA256: {
+		Name: SHA256,
+		LabelHash: func(challenge []byte, difficulty uint32) func(data []byte) []byte {
+			return GenLabelHashFuncWithDifficulty(challenge, LabelDifficultyOrDefault(difficulty))
+		},
+		MerkleHash: GenMerkleHashFunc,
+		FiatShamir: shared.FiatShamir,
+	},
+	SHA3: {
+		Name: SHA3,
+		LabelHash: func(challenge []byte, difficulty uint32) func(data []byte) []byte {
+			return genLabelHashFunc(sha3.Sum256, challenge, LabelDifficultyOrDefault(difficulty))
+		},
+		MerkleHash: func(challenge []byte) func(lChild, rChild []byte) []byte {
+			return genMerkleHashFunc(sha3.Sum256, challenge)
+		},
+		FiatShamir: shared.MakeFiatShamirFunc(sha3.Sum256),
+	},
+}
+
+// SuiteByName returns the hash suite of the given name.
+// The empty name stands for SHA256, the suite of the proofs that predate hash suites.
+func SuiteByName(name string) (*Suite, error) {
+	if name == "" {
+		name = SHA256
+	}
+	suite, ok := suites[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q (supported: %v)", ErrUnknownSuite, name, SuiteNames())
+	}
+	return suite, nil
+}
+
+// SuiteNames returns the names of the supported hash suites.
+func SuiteNames() []string {
+	names := make([]string, 0, len(suites))
+	for name := range suites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LabelDifficultyOrDefault returns the given label difficulty, or LabelHashNestingDepth if it is zero.
+func LabelDifficultyOrDefault(difficulty uint32) uint32 {
+	if difficulty == 0 {
+		return LabelHashNestingDepth
+	}
+	return difficulty
+}
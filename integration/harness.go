@@ -28,6 +28,7 @@ type Harness struct {
 	server *server
 	conn   *grpc.ClientConn
 	api.PoetServiceClient
+	api.AdminServiceClient
 }
 
 // NewHarness creates and initializes a new instance of Harness.
@@ -50,16 +51,17 @@ func NewHarness(ctx context.Context, cfg *ServerConfig) (*Harness, error) {
 
 	// Verify the client connectivity.
 	// If failed, shutdown the server.
-	conn, err := connectClient(ctx, cfg.rpcListen)
+	conn, err := connectClient(ctx, cfg.rpcListen, cfg.AdminToken)
 	if err != nil {
 		_ = server.shutdown(true)
 		return nil, err
 	}
 
 	h := &Harness{
-		server:            server,
-		conn:              conn,
-		PoetServiceClient: api.NewPoetServiceClient(conn),
+		server:             server,
+		conn:               conn,
+		PoetServiceClient:  api.NewPoetServiceClient(conn),
+		AdminServiceClient: api.NewAdminServiceClient(conn),
 	}
 
 	return h, nil
@@ -102,10 +104,11 @@ func (h *Harness) RESTListen() string {
 }
 
 // connectClient attempts to establish a gRPC Client connection
-// to the provided target.
-func connectClient(ctx context.Context, target string) (*grpc.ClientConn, error) {
+// to the provided target, authenticating the admin RPC calls with the token.
+func connectClient(ctx context.Context, target, adminToken string) (*grpc.ClientConn, error) {
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(tokenCredentials(adminToken)),
 		grpc.WithBlock(),
 	}
 	conn, err := grpc.DialContext(ctx, target, opts...)
@@ -116,6 +119,17 @@ func connectClient(ctx context.Context, target string) (*grpc.ClientConn, error)
 	return conn, nil
 }
 
+// tokenCredentials authenticate the calls with a bearer token, over insecure connections too.
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
 // baseDir is the directory path of the temp directory for all the harness files.
 func baseDir() (string, error) {
 	baseDir := filepath.Join(os.TempDir(), "poet")
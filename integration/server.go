@@ -27,6 +27,8 @@ type ServerConfig struct {
 	RESTListen       string
 	GatewayAddresses []string
 	GtwConnTimeout   time.Duration
+	// AdminToken authenticates the calls of the harness to the admin RPCs.
+	AdminToken string
 }
 
 // DefaultConfig returns a newConfig with all default values.
@@ -50,6 +52,7 @@ func DefaultConfig() (*ServerConfig, error) {
 		PhaseShift:     time.Second / 2,
 		CycleGap:       time.Second / 4,
 		GtwConnTimeout: time.Second * 10,
+		AdminToken:     "integration",
 	}
 
 	return cfg, nil
@@ -68,6 +71,10 @@ func (cfg *ServerConfig) genArgs() []string {
 	args = append(args, fmt.Sprintf("--cycle-gap=%s", cfg.CycleGap))
 	args = append(args, fmt.Sprintf("--gtw-connection-timeout=%s", cfg.GtwConnTimeout.String()))
 
+	if cfg.AdminToken != "" {
+		args = append(args, fmt.Sprintf("--admin.token=%s", cfg.AdminToken))
+	}
+
 	for _, address := range cfg.GatewayAddresses {
 		args = append(args, fmt.Sprintf("--gateway=%s", address))
 	}
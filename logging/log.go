@@ -2,6 +2,7 @@ package logging
 
 import (
 	"context"
+	"io"
 	"os"
 
 	"go.uber.org/zap"
@@ -48,3 +49,16 @@ func New(level zapcore.LevelEnabler, logFileName string, json bool) *zap.Logger
 
 	return zap.New(zapcore.NewTee(cores...))
 }
+
+// NewAudit returns a logger writing JSON entries to the given file only, such as the audit log.
+// The returned closer closes the file.
+func NewAudit(logFileName string) (*zap.Logger, io.Closer) {
+	fileLogger := &lumberjack.Logger{
+		Filename: logFileName,
+		MaxSize:  500,
+		MaxAge:   28,
+		Compress: true,
+	}
+	encoder := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	return zap.New(zapcore.NewCore(encoder, zapcore.AddSync(fileLogger), zap.InfoLevel)), fileLogger
+}
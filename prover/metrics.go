@@ -0,0 +1,23 @@
+package prover
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	checkpointDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "poet",
+		Subsystem: "prover",
+		Name:      "checkpoint_duration_seconds",
+		Help:      "Time to persist the state of the proof generation.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 12),
+	})
+	proofGenerationDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "poet",
+		Subsystem: "prover",
+		Name:      "proof_generation_duration_seconds",
+		Help:      "Time to generate the proof once the construction of the proving tree stopped, e.g. at the deadline.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 16),
+	})
+)
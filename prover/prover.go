@@ -28,6 +28,12 @@ const (
 	// The rate, in leaves, in which the proof generation state snapshot will be saved to disk
 	// to allow potential crash recovery.
 	hardShutdownCheckpointRate = 1 << 24
+
+	// ProgressReportInterval is the interval in which the proof generation progress is reported.
+	ProgressReportInterval = time.Second
+
+	// The rate, in leaves, in which the time is checked against the progress report interval.
+	progressCheckRate = 1 << 10
 )
 
 var ErrShutdownRequested = errors.New("shutdown requested")
@@ -36,6 +42,42 @@ type persistFunc func(ctx context.Context, tree *merkle.Tree, treeCache *cache.W
 
 var persist persistFunc = func(context.Context, *merkle.Tree, *cache.Writer, uint64) error { return nil }
 
+// clock tells the time to the construction of the proving tree, for its limit and progress reports.
+var clock = time.Now
+
+// Limit tells when the construction of the proving tree stops.
+// It stops once the tree has Leaves leaves if Leaves is set, regardless of the time it takes,
+// and at the Deadline otherwise.
+type Limit struct {
+	Deadline time.Time
+	Leaves   uint64
+}
+
+func (l Limit) reached(now time.Time, leaves uint64) bool {
+	if l.Leaves != 0 {
+		return leaves >= l.Leaves
+	}
+	return !now.Before(l.Deadline)
+}
+
+// Progress is a snapshot of the progress of a proof generation.
+type Progress struct {
+	// Leaves is the number of leaves added to the proving tree so far.
+	Leaves uint64
+	// LeavesPerSecond is the rate in which leaves were added since the previous report.
+	LeavesPerSecond float64
+	// ProjectedLeaves is the number of leaves expected when the generation stops.
+	// With a deadline, it assumes the current rate is kept.
+	ProjectedLeaves uint64
+	// TimeLeft is the time left until the generation stops.
+	// With a fixed number of leaves, it assumes the current rate is kept.
+	TimeLeft time.Duration
+}
+
+// ProgressFunc is called every ProgressReportInterval while the proving tree is constructed.
+// It must not block.
+type ProgressFunc func(ctx context.Context, progress Progress)
+
 // GenerateProof computes the PoET DAG, uses Fiat-Shamir to derive a challenge from the Merkle root and generates a Merkle
 // proof using the challenge and the DAG.
 func GenerateProof(
@@ -43,10 +85,12 @@ func GenerateProof(
 	datadir string,
 	labelHashFunc func(data []byte) []byte,
 	merkleHashFunc func(lChild, rChild []byte) []byte,
-	limit time.Time,
+	fiatShamir shared.FiatShamirFunc,
+	limit Limit,
 	securityParam uint8,
 	minMemoryLayer uint,
 	persist persistFunc,
+	progress ProgressFunc,
 ) (uint64, *shared.MerkleProof, error) {
 	tree, treeCache, err := makeProofTree(datadir, merkleHashFunc, minMemoryLayer)
 	if err != nil {
@@ -54,7 +98,7 @@ func GenerateProof(
 	}
 	defer treeCache.Close()
 
-	return generateProof(ctx, labelHashFunc, tree, treeCache, limit, 0, securityParam, persist)
+	return generateProof(ctx, labelHashFunc, fiatShamir, tree, treeCache, limit, 0, securityParam, persist, progress)
 }
 
 // GenerateProofRecovery recovers proof generation, from a given 'nextLeafID' and for a given 'parkedNodes' snapshot.
@@ -63,11 +107,13 @@ func GenerateProofRecovery(
 	datadir string,
 	labelHashFunc func(data []byte) []byte,
 	merkleHashFunc func(lChild, rChild []byte) []byte,
-	limit time.Time,
+	fiatShamir shared.FiatShamirFunc,
+	limit Limit,
 	securityParam uint8,
 	nextLeafID uint64,
 	parkedNodes [][]byte,
 	persist persistFunc,
+	progress ProgressFunc,
 ) (uint64, *shared.MerkleProof, error) {
 	treeCache, tree, err := makeRecoveryProofTree(ctx, datadir, merkleHashFunc, nextLeafID, parkedNodes)
 	if err != nil {
@@ -75,7 +121,7 @@ func GenerateProofRecovery(
 	}
 	defer treeCache.Close()
 
-	return generateProof(ctx, labelHashFunc, tree, treeCache, limit, nextLeafID, securityParam, persist)
+	return generateProof(ctx, labelHashFunc, fiatShamir, tree, treeCache, limit, nextLeafID, securityParam, persist, progress)
 }
 
 // GenerateProofWithoutPersistency calls GenerateProof with disabled persistency functionality
@@ -85,11 +131,27 @@ func GenerateProofWithoutPersistency(
 	datadir string,
 	labelHashFunc func(data []byte) []byte,
 	merkleHashFunc func(lChild, rChild []byte) []byte,
+	fiatShamir shared.FiatShamirFunc,
 	limit time.Time,
 	securityParam uint8,
 	minMemoryLayer uint,
 ) (uint64, *shared.MerkleProof, error) {
-	return GenerateProof(ctx, datadir, labelHashFunc, merkleHashFunc, limit, securityParam, minMemoryLayer, persist)
+	return GenerateProof(ctx, datadir, labelHashFunc, merkleHashFunc, fiatShamir, Limit{Deadline: limit}, securityParam, minMemoryLayer, persist, nil)
+}
+
+// GenerateFixedProofWithoutPersistency is like GenerateProofWithoutPersistency,
+// but constructs a proving tree of exactly `numLeaves` leaves, making the proof deterministic.
+func GenerateFixedProofWithoutPersistency(
+	ctx context.Context,
+	datadir string,
+	labelHashFunc func(data []byte) []byte,
+	merkleHashFunc func(lChild, rChild []byte) []byte,
+	fiatShamir shared.FiatShamirFunc,
+	numLeaves uint64,
+	securityParam uint8,
+	minMemoryLayer uint,
+) (uint64, *shared.MerkleProof, error) {
+	return GenerateProof(ctx, datadir, labelHashFunc, merkleHashFunc, fiatShamir, Limit{Leaves: numLeaves}, securityParam, minMemoryLayer, persist, nil)
 }
 
 func makeProofTree(
@@ -194,20 +256,27 @@ func makeRecoveryProofTree(
 func generateProof(
 	ctx context.Context,
 	labelHashFunc func(data []byte) []byte,
+	fiatShamir shared.FiatShamirFunc,
 	tree *merkle.Tree,
 	treeCache *cache.Writer,
-	end time.Time,
+	limit Limit,
 	nextLeafID uint64,
 	securityParam uint8,
 	persist persistFunc,
+	progress ProgressFunc,
 ) (uint64, *shared.MerkleProof, error) {
 	makeLabel := shared.MakeLabelFunc()
+	checkpoint := func(leafID uint64) error {
+		defer func(start time.Time) { checkpointDuration.Observe(time.Since(start).Seconds()) }(time.Now())
+		return persist(ctx, tree, treeCache, leafID)
+	}
 	leaves := nextLeafID
-	for leafID := nextLeafID; time.Until(end) > 0; leafID++ {
+	lastReport, lastReportLeaves := clock(), leaves
+	for leafID := nextLeafID; !limit.reached(clock(), leafID); leafID++ {
 		// Handle persistence.
 		select {
 		case <-ctx.Done():
-			if err := persist(ctx, tree, treeCache, leafID); err != nil {
+			if err := checkpoint(leafID); err != nil {
 				return 0, nil, fmt.Errorf("%w: error happened during persisting: %v", ErrShutdownRequested, err)
 			}
 			return 0, nil, ErrShutdownRequested
@@ -215,11 +284,29 @@ func generateProof(
 		}
 
 		if leafID != 0 && leafID%hardShutdownCheckpointRate == 0 {
-			if err := persist(ctx, tree, treeCache, leafID); err != nil {
+			if err := checkpoint(leafID); err != nil {
 				return 0, nil, err
 			}
 		}
 
+		if progress != nil && leafID%progressCheckRate == 0 {
+			if now := clock(); now.Sub(lastReport) >= ProgressReportInterval {
+				rate := float64(leaves-lastReportLeaves) / now.Sub(lastReport).Seconds()
+				report := Progress{Leaves: leaves, LeavesPerSecond: rate}
+				if limit.Leaves != 0 {
+					report.ProjectedLeaves = limit.Leaves
+					if rate > 0 {
+						report.TimeLeft = time.Duration(float64(limit.Leaves-leaves) / rate * float64(time.Second))
+					}
+				} else {
+					report.TimeLeft = limit.Deadline.Sub(now)
+					report.ProjectedLeaves = leaves + uint64(rate*report.TimeLeft.Seconds())
+				}
+				progress(ctx, report)
+				lastReport, lastReportLeaves = now, leaves
+			}
+		}
+
 		// Generate the next leaf.
 		err := tree.AddLeaf(makeLabel(labelHashFunc, leafID, tree.GetParkedNodes()))
 		if err != nil {
@@ -229,6 +316,7 @@ func generateProof(
 	}
 
 	logging.FromContext(ctx).Sugar().Infof("Merkle tree construction finished with %d leaves, generating proof...", leaves)
+	defer func(start time.Time) { proofGenerationDuration.Observe(time.Since(start).Seconds()) }(time.Now())
 
 	root := tree.Root()
 
@@ -236,7 +324,7 @@ func generateProof(
 	if err != nil {
 		return 0, nil, err
 	}
-	provenLeafIndices := shared.FiatShamir(root, leaves, securityParam)
+	provenLeafIndices := fiatShamir(root, leaves, securityParam)
 	_, provenLeaves, proofNodes, err := merkle.GenerateProof(provenLeafIndices, cacheReader)
 	if err != nil {
 		return 0, nil, err
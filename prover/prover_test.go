@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/spacemeshos/merkle-tree"
+	"github.com/spacemeshos/merkle-tree/cache"
 	"github.com/stretchr/testify/require"
 
 	"github.com/spacemeshos/poet/hash"
@@ -16,22 +18,112 @@ func TestGetProof(t *testing.T) {
 	tempdir := t.TempDir()
 
 	challenge := []byte("challenge this")
-	leafs, merkleProof, err := GenerateProofWithoutPersistency(context.Background(), tempdir, hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), time.Now().Add(10*time.Millisecond), 5, LowestMerkleMinMemoryLayer)
+	leafs, merkleProof, err := GenerateProofWithoutPersistency(context.Background(), tempdir, hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), shared.FiatShamir, time.Now().Add(10*time.Millisecond), 5, LowestMerkleMinMemoryLayer)
 	r.NoError(err)
 	t.Logf("root: %x", merkleProof.Root)
 	t.Logf("proof: %x", merkleProof.ProvenLeaves)
 	t.Logf("leafs: %d", leafs)
 }
 
+func TestGenerateProof_ReportsProgress(t *testing.T) {
+	r := require.New(t)
+
+	challenge := []byte("challenge this")
+	var reports []Progress
+	report := func(_ context.Context, progress Progress) { reports = append(reports, progress) }
+
+	// Every leaf takes a millisecond, so that a report is due every progressCheckRate leaves.
+	end := fakeClock(t, time.Millisecond).Add(ProgressReportInterval * 3 / 2)
+	leafs, _, err := GenerateProof(context.Background(), t.TempDir(), hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), shared.FiatShamir, Limit{Deadline: end}, 5, LowestMerkleMinMemoryLayer, persist, report)
+	r.NoError(err)
+
+	r.Len(reports, 1)
+	r.EqualValues(progressCheckRate, reports[0].Leaves)
+	r.Less(reports[0].Leaves, leafs)
+	r.Positive(reports[0].LeavesPerSecond)
+	r.Greater(reports[0].ProjectedLeaves, reports[0].Leaves)
+	r.Positive(reports[0].TimeLeft)
+	r.LessOrEqual(reports[0].TimeLeft, ProgressReportInterval/2)
+}
+
+func TestGenerateProof_ReportsProgressOfFixedLeaves(t *testing.T) {
+	r := require.New(t)
+
+	challenge := []byte("challenge this")
+	var reports []Progress
+	report := func(_ context.Context, progress Progress) { reports = append(reports, progress) }
+
+	fakeClock(t, time.Millisecond)
+	numLeaves := uint64(4 * progressCheckRate)
+	leafs, _, err := GenerateProof(context.Background(), t.TempDir(), hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), shared.FiatShamir, Limit{Leaves: numLeaves}, 5, LowestMerkleMinMemoryLayer, persist, report)
+	r.NoError(err)
+	r.Equal(numLeaves, leafs)
+
+	r.Len(reports, 3)
+	for i, report := range reports {
+		r.EqualValues((i+1)*progressCheckRate, report.Leaves)
+		r.Positive(report.LeavesPerSecond)
+		r.Equal(numLeaves, report.ProjectedLeaves)
+		r.Positive(report.TimeLeft)
+	}
+}
+
+func TestGenerateProof_FixedLeaves(t *testing.T) {
+	r := require.New(t)
+
+	challenge := []byte("challenge this")
+	numLeaves := uint64(1 << 15)
+	leafs, expected, err := GenerateFixedProofWithoutPersistency(context.Background(), t.TempDir(), hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), shared.FiatShamir, numLeaves, 5, LowestMerkleMinMemoryLayer)
+	r.NoError(err)
+	r.Equal(numLeaves, leafs)
+
+	// Interrupt the generation of the same proof and recover it.
+	tempdir := t.TempDir()
+	var nextLeafID uint64
+	var parkedNodes [][]byte
+	checkpoint := func(_ context.Context, tree *merkle.Tree, treeCache *cache.Writer, leafID uint64) error {
+		nextLeafID, parkedNodes = leafID, tree.GetParkedNodes()
+		_, err := treeCache.GetReader()
+		return err
+	}
+	// The generation is interrupted by the first progress report, due after progressCheckRate leaves,
+	// once the leaf being generated is added.
+	fakeClock(t, time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	interrupt := func(context.Context, Progress) { cancel() }
+	_, _, err = GenerateProof(ctx, tempdir, hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), shared.FiatShamir, Limit{Leaves: numLeaves}, 5, LowestMerkleMinMemoryLayer, checkpoint, interrupt)
+	r.ErrorIs(err, ErrShutdownRequested)
+	r.EqualValues(progressCheckRate+1, nextLeafID)
+
+	leafs, recovered, err := GenerateProofRecovery(context.Background(), tempdir, hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), shared.FiatShamir, Limit{Leaves: numLeaves}, 5, nextLeafID, parkedNodes, checkpoint, nil)
+	r.NoError(err)
+	r.Equal(numLeaves, leafs)
+	r.Equal(expected, recovered)
+}
+
 func BenchmarkGetProof(b *testing.B) {
 	tempdir := b.TempDir()
 
 	challenge := []byte("challenge this! challenge this! ")
 	securityParam := shared.T
 	duration := 10 * time.Millisecond
-	leafs, _, err := GenerateProofWithoutPersistency(context.Background(), tempdir, hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), time.Now().Add(duration), securityParam, LowestMerkleMinMemoryLayer)
+	leafs, _, err := GenerateProofWithoutPersistency(context.Background(), tempdir, hash.GenLabelHashFunc(challenge), hash.GenMerkleHashFunc(challenge), shared.FiatShamir, time.Now().Add(duration), securityParam, LowestMerkleMinMemoryLayer)
 	if err != nil {
 		b.Fatal(err)
 	}
 	b.ReportMetric(float64(leafs), "leafs/op")
 }
+
+// fakeClock replaces the clock of the proof generation with one that advances by `step`
+// whenever it is read, and returns its starting time.
+func fakeClock(t *testing.T, step time.Duration) time.Time {
+	start := time.Now()
+	current := start
+	clock = func() time.Time {
+		current = current.Add(step)
+		return current
+	}
+	t.Cleanup(func() { clock = time.Now })
+	return start
+}
@@ -22,11 +22,6 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PoetServiceClient interface {
-	// Start is used to start the service.
-	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
-	// UpdateGateway allows to update the list of gateway addresses,
-	// similar to the Start rpc, but after the service already started.
-	UpdateGateway(ctx context.Context, in *UpdateGatewayRequest, opts ...grpc.CallOption) (*UpdateGatewayResponse, error)
 	// Submit adds a challenge to the service's current open round,
 	// to be included its later generated proof.
 	Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitResponse, error)
@@ -35,6 +30,31 @@ type PoetServiceClient interface {
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
 	// GetProof returns the generated proof for given round id.
 	GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*GetProofResponse, error)
+	// WaitForProof waits until the proof of the given round is generated, up to the timeout if one is given
+	// and at most up to a maximum set by the server. It fails with NotFound for a round the service won't execute.
+	// It streams the proof without its members first, followed by the members in chunks,
+	// so that the proofs of large rounds fit in the message size limits.
+	WaitForProof(ctx context.Context, in *WaitForProofRequest, opts ...grpc.CallOption) (PoetService_WaitForProofClient, error)
+	// GetMembershipProof returns a proof of inclusion of a member,
+	// identified either by its challenge or by the node that registered it,
+	// in the statement of the given round.
+	GetMembershipProof(ctx context.Context, in *GetMembershipProofRequest, opts ...grpc.CallOption) (*GetMembershipProofResponse, error)
+	// FindRegistration returns the rounds, and the indices in their proofs, of a member
+	// identified either by its challenge or by the node that registered it.
+	FindRegistration(ctx context.Context, in *FindRegistrationRequest, opts ...grpc.CallOption) (*FindRegistrationResponse, error)
+	// GetRegistration returns the registrations of a node in the open round and the executing rounds,
+	// with the challenge hashes stored for it and the scheduled ends of the rounds.
+	GetRegistration(ctx context.Context, in *GetRegistrationRequest, opts ...grpc.CallOption) (*GetRegistrationResponse, error)
+	// ListRounds returns the rounds known to the service, open, executing or executed.
+	ListRounds(ctx context.Context, in *ListRoundsRequest, opts ...grpc.CallOption) (*ListRoundsResponse, error)
+	// GetRound returns the round with the given id.
+	GetRound(ctx context.Context, in *GetRoundRequest, opts ...grpc.CallOption) (*GetRoundResponse, error)
+	// GetRoundProgress returns the last reported progress of the proof generation of an executing round.
+	GetRoundProgress(ctx context.Context, in *GetRoundProgressRequest, opts ...grpc.CallOption) (*GetRoundProgressResponse, error)
+	// SubscribeEvents streams the lifecycle events of the rounds as they happen.
+	// Over REST, the events are streamed as newline-delimited JSON,
+	// or as server-sent events if `text/event-stream` is accepted.
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (PoetService_SubscribeEventsClient, error)
 }
 
 type poetServiceClient struct {
@@ -45,60 +65,155 @@ func NewPoetServiceClient(cc grpc.ClientConnInterface) PoetServiceClient {
 	return &poetServiceClient{cc}
 }
 
-func (c *poetServiceClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
-	out := new(StartResponse)
-	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/Start", in, out, opts...)
+func (c *poetServiceClient) Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitResponse, error) {
+	out := new(SubmitResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/Submit", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *poetServiceClient) UpdateGateway(ctx context.Context, in *UpdateGatewayRequest, opts ...grpc.CallOption) (*UpdateGatewayResponse, error) {
-	out := new(UpdateGatewayResponse)
-	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/UpdateGateway", in, out, opts...)
+func (c *poetServiceClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/GetInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *poetServiceClient) Submit(ctx context.Context, in *SubmitRequest, opts ...grpc.CallOption) (*SubmitResponse, error) {
-	out := new(SubmitResponse)
-	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/Submit", in, out, opts...)
+func (c *poetServiceClient) GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*GetProofResponse, error) {
+	out := new(GetProofResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/GetProof", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *poetServiceClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
-	out := new(GetInfoResponse)
-	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/GetInfo", in, out, opts...)
+func (c *poetServiceClient) WaitForProof(ctx context.Context, in *WaitForProofRequest, opts ...grpc.CallOption) (PoetService_WaitForProofClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PoetService_ServiceDesc.Streams[0], "/rpc.api.v1.PoetService/WaitForProof", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &poetServiceWaitForProofClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PoetService_WaitForProofClient interface {
+	Recv() (*WaitForProofResponse, error)
+	grpc.ClientStream
+}
+
+type poetServiceWaitForProofClient struct {
+	grpc.ClientStream
+}
+
+func (x *poetServiceWaitForProofClient) Recv() (*WaitForProofResponse, error) {
+	m := new(WaitForProofResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *poetServiceClient) GetMembershipProof(ctx context.Context, in *GetMembershipProofRequest, opts ...grpc.CallOption) (*GetMembershipProofResponse, error) {
+	out := new(GetMembershipProofResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/GetMembershipProof", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *poetServiceClient) GetProof(ctx context.Context, in *GetProofRequest, opts ...grpc.CallOption) (*GetProofResponse, error) {
-	out := new(GetProofResponse)
-	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/GetProof", in, out, opts...)
+func (c *poetServiceClient) FindRegistration(ctx context.Context, in *FindRegistrationRequest, opts ...grpc.CallOption) (*FindRegistrationResponse, error) {
+	out := new(FindRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/FindRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poetServiceClient) GetRegistration(ctx context.Context, in *GetRegistrationRequest, opts ...grpc.CallOption) (*GetRegistrationResponse, error) {
+	out := new(GetRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/GetRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poetServiceClient) ListRounds(ctx context.Context, in *ListRoundsRequest, opts ...grpc.CallOption) (*ListRoundsResponse, error) {
+	out := new(ListRoundsResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/ListRounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poetServiceClient) GetRound(ctx context.Context, in *GetRoundRequest, opts ...grpc.CallOption) (*GetRoundResponse, error) {
+	out := new(GetRoundResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/GetRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *poetServiceClient) GetRoundProgress(ctx context.Context, in *GetRoundProgressRequest, opts ...grpc.CallOption) (*GetRoundProgressResponse, error) {
+	out := new(GetRoundProgressResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.PoetService/GetRoundProgress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
+func (c *poetServiceClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (PoetService_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PoetService_ServiceDesc.Streams[1], "/rpc.api.v1.PoetService/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &poetServiceSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PoetService_SubscribeEventsClient interface {
+	Recv() (*SubscribeEventsResponse, error)
+	grpc.ClientStream
+}
+
+type poetServiceSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *poetServiceSubscribeEventsClient) Recv() (*SubscribeEventsResponse, error) {
+	m := new(SubscribeEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PoetServiceServer is the server API for PoetService service.
 // All implementations should embed UnimplementedPoetServiceServer
 // for forward compatibility
 type PoetServiceServer interface {
-	// Start is used to start the service.
-	Start(context.Context, *StartRequest) (*StartResponse, error)
-	// UpdateGateway allows to update the list of gateway addresses,
-	// similar to the Start rpc, but after the service already started.
-	UpdateGateway(context.Context, *UpdateGatewayRequest) (*UpdateGatewayResponse, error)
 	// Submit adds a challenge to the service's current open round,
 	// to be included its later generated proof.
 	Submit(context.Context, *SubmitRequest) (*SubmitResponse, error)
@@ -107,18 +222,37 @@ type PoetServiceServer interface {
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
 	// GetProof returns the generated proof for given round id.
 	GetProof(context.Context, *GetProofRequest) (*GetProofResponse, error)
+	// WaitForProof waits until the proof of the given round is generated, up to the timeout if one is given
+	// and at most up to a maximum set by the server. It fails with NotFound for a round the service won't execute.
+	// It streams the proof without its members first, followed by the members in chunks,
+	// so that the proofs of large rounds fit in the message size limits.
+	WaitForProof(*WaitForProofRequest, PoetService_WaitForProofServer) error
+	// GetMembershipProof returns a proof of inclusion of a member,
+	// identified either by its challenge or by the node that registered it,
+	// in the statement of the given round.
+	GetMembershipProof(context.Context, *GetMembershipProofRequest) (*GetMembershipProofResponse, error)
+	// FindRegistration returns the rounds, and the indices in their proofs, of a member
+	// identified either by its challenge or by the node that registered it.
+	FindRegistration(context.Context, *FindRegistrationRequest) (*FindRegistrationResponse, error)
+	// GetRegistration returns the registrations of a node in the open round and the executing rounds,
+	// with the challenge hashes stored for it and the scheduled ends of the rounds.
+	GetRegistration(context.Context, *GetRegistrationRequest) (*GetRegistrationResponse, error)
+	// ListRounds returns the rounds known to the service, open, executing or executed.
+	ListRounds(context.Context, *ListRoundsRequest) (*ListRoundsResponse, error)
+	// GetRound returns the round with the given id.
+	GetRound(context.Context, *GetRoundRequest) (*GetRoundResponse, error)
+	// GetRoundProgress returns the last reported progress of the proof generation of an executing round.
+	GetRoundProgress(context.Context, *GetRoundProgressRequest) (*GetRoundProgressResponse, error)
+	// SubscribeEvents streams the lifecycle events of the rounds as they happen.
+	// Over REST, the events are streamed as newline-delimited JSON,
+	// or as server-sent events if `text/event-stream` is accepted.
+	SubscribeEvents(*SubscribeEventsRequest, PoetService_SubscribeEventsServer) error
 }
 
 // UnimplementedPoetServiceServer should be embedded to have forward compatible implementations.
 type UnimplementedPoetServiceServer struct {
 }
 
-func (UnimplementedPoetServiceServer) Start(context.Context, *StartRequest) (*StartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
-}
-func (UnimplementedPoetServiceServer) UpdateGateway(context.Context, *UpdateGatewayRequest) (*UpdateGatewayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGateway not implemented")
-}
 func (UnimplementedPoetServiceServer) Submit(context.Context, *SubmitRequest) (*SubmitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
 }
@@ -128,6 +262,30 @@ func (UnimplementedPoetServiceServer) GetInfo(context.Context, *GetInfoRequest)
 func (UnimplementedPoetServiceServer) GetProof(context.Context, *GetProofRequest) (*GetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProof not implemented")
 }
+func (UnimplementedPoetServiceServer) WaitForProof(*WaitForProofRequest, PoetService_WaitForProofServer) error {
+	return status.Errorf(codes.Unimplemented, "method WaitForProof not implemented")
+}
+func (UnimplementedPoetServiceServer) GetMembershipProof(context.Context, *GetMembershipProofRequest) (*GetMembershipProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMembershipProof not implemented")
+}
+func (UnimplementedPoetServiceServer) FindRegistration(context.Context, *FindRegistrationRequest) (*FindRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRegistration not implemented")
+}
+func (UnimplementedPoetServiceServer) GetRegistration(context.Context, *GetRegistrationRequest) (*GetRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistration not implemented")
+}
+func (UnimplementedPoetServiceServer) ListRounds(context.Context, *ListRoundsRequest) (*ListRoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRounds not implemented")
+}
+func (UnimplementedPoetServiceServer) GetRound(context.Context, *GetRoundRequest) (*GetRoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRound not implemented")
+}
+func (UnimplementedPoetServiceServer) GetRoundProgress(context.Context, *GetRoundProgressRequest) (*GetRoundProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoundProgress not implemented")
+}
+func (UnimplementedPoetServiceServer) SubscribeEvents(*SubscribeEventsRequest, PoetService_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
 
 // UnsafePoetServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to PoetServiceServer will
@@ -140,96 +298,210 @@ func RegisterPoetServiceServer(s grpc.ServiceRegistrar, srv PoetServiceServer) {
 	s.RegisterService(&PoetService_ServiceDesc, srv)
 }
 
-func _PoetService_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartRequest)
+func _PoetService_Submit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PoetServiceServer).Start(ctx, in)
+		return srv.(PoetServiceServer).Submit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.api.v1.PoetService/Start",
+		FullMethod: "/rpc.api.v1.PoetService/Submit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PoetServiceServer).Start(ctx, req.(*StartRequest))
+		return srv.(PoetServiceServer).Submit(ctx, req.(*SubmitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PoetService_UpdateGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateGatewayRequest)
+func _PoetService_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PoetServiceServer).UpdateGateway(ctx, in)
+		return srv.(PoetServiceServer).GetInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.api.v1.PoetService/UpdateGateway",
+		FullMethod: "/rpc.api.v1.PoetService/GetInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PoetServiceServer).UpdateGateway(ctx, req.(*UpdateGatewayRequest))
+		return srv.(PoetServiceServer).GetInfo(ctx, req.(*GetInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PoetService_Submit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitRequest)
+func _PoetService_GetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProofRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PoetServiceServer).Submit(ctx, in)
+		return srv.(PoetServiceServer).GetProof(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.api.v1.PoetService/Submit",
+		FullMethod: "/rpc.api.v1.PoetService/GetProof",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PoetServiceServer).Submit(ctx, req.(*SubmitRequest))
+		return srv.(PoetServiceServer).GetProof(ctx, req.(*GetProofRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PoetService_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _PoetService_WaitForProof_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WaitForProofRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PoetServiceServer).WaitForProof(m, &poetServiceWaitForProofServer{stream})
+}
+
+type PoetService_WaitForProofServer interface {
+	Send(*WaitForProofResponse) error
+	grpc.ServerStream
+}
+
+type poetServiceWaitForProofServer struct {
+	grpc.ServerStream
+}
+
+func (x *poetServiceWaitForProofServer) Send(m *WaitForProofResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PoetService_GetMembershipProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMembershipProofRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PoetServiceServer).GetInfo(ctx, in)
+		return srv.(PoetServiceServer).GetMembershipProof(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.api.v1.PoetService/GetInfo",
+		FullMethod: "/rpc.api.v1.PoetService/GetMembershipProof",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PoetServiceServer).GetInfo(ctx, req.(*GetInfoRequest))
+		return srv.(PoetServiceServer).GetMembershipProof(ctx, req.(*GetMembershipProofRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PoetService_GetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProofRequest)
+func _PoetService_FindRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRegistrationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PoetServiceServer).GetProof(ctx, in)
+		return srv.(PoetServiceServer).FindRegistration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpc.api.v1.PoetService/GetProof",
+		FullMethod: "/rpc.api.v1.PoetService/FindRegistration",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PoetServiceServer).GetProof(ctx, req.(*GetProofRequest))
+		return srv.(PoetServiceServer).FindRegistration(ctx, req.(*FindRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoetService_GetRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoetServiceServer).GetRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.api.v1.PoetService/GetRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoetServiceServer).GetRegistration(ctx, req.(*GetRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoetService_ListRounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoetServiceServer).ListRounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.api.v1.PoetService/ListRounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoetServiceServer).ListRounds(ctx, req.(*ListRoundsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PoetService_GetRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoetServiceServer).GetRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.api.v1.PoetService/GetRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoetServiceServer).GetRound(ctx, req.(*GetRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoetService_GetRoundProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoundProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PoetServiceServer).GetRoundProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.api.v1.PoetService/GetRoundProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PoetServiceServer).GetRoundProgress(ctx, req.(*GetRoundProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PoetService_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PoetServiceServer).SubscribeEvents(m, &poetServiceSubscribeEventsServer{stream})
+}
+
+type PoetService_SubscribeEventsServer interface {
+	Send(*SubscribeEventsResponse) error
+	grpc.ServerStream
+}
+
+type poetServiceSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *poetServiceSubscribeEventsServer) Send(m *SubscribeEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // PoetService_ServiceDesc is the grpc.ServiceDesc for PoetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -237,14 +509,6 @@ var PoetService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "rpc.api.v1.PoetService",
 	HandlerType: (*PoetServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Start",
-			Handler:    _PoetService_Start_Handler,
-		},
-		{
-			MethodName: "UpdateGateway",
-			Handler:    _PoetService_UpdateGateway_Handler,
-		},
 		{
 			MethodName: "Submit",
 			Handler:    _PoetService_Submit_Handler,
@@ -257,6 +521,335 @@ var PoetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProof",
 			Handler:    _PoetService_GetProof_Handler,
 		},
+		{
+			MethodName: "GetMembershipProof",
+			Handler:    _PoetService_GetMembershipProof_Handler,
+		},
+		{
+			MethodName: "FindRegistration",
+			Handler:    _PoetService_FindRegistration_Handler,
+		},
+		{
+			MethodName: "GetRegistration",
+			Handler:    _PoetService_GetRegistration_Handler,
+		},
+		{
+			MethodName: "ListRounds",
+			Handler:    _PoetService_ListRounds_Handler,
+		},
+		{
+			MethodName: "GetRound",
+			Handler:    _PoetService_GetRound_Handler,
+		},
+		{
+			MethodName: "GetRoundProgress",
+			Handler:    _PoetService_GetRoundProgress_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WaitForProof",
+			Handler:       _PoetService_WaitForProof_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _PoetService_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc/api/v1/api.proto",
+}
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// Start is used to start the service.
+	Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error)
+	// UpdateGateway allows to update the list of gateway addresses,
+	// similar to the Start rpc, but after the service already started.
+	UpdateGateway(ctx context.Context, in *UpdateGatewayRequest, opts ...grpc.CallOption) (*UpdateGatewayResponse, error)
+	// ListGateways returns the connectivity status of the gateways,
+	// including the ones the service keeps reconnecting to.
+	ListGateways(ctx context.Context, in *ListGatewaysRequest, opts ...grpc.CallOption) (*ListGatewaysResponse, error)
+	// ListRegistrations returns the challenges registered in a round,
+	// with the provenance of their verification.
+	ListRegistrations(ctx context.Context, in *ListRegistrationsRequest, opts ...grpc.CallOption) (*ListRegistrationsResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) Start(ctx context.Context, in *StartRequest, opts ...grpc.CallOption) (*StartResponse, error) {
+	out := new(StartResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.AdminService/Start", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateGateway(ctx context.Context, in *UpdateGatewayRequest, opts ...grpc.CallOption) (*UpdateGatewayResponse, error) {
+	out := new(UpdateGatewayResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.AdminService/UpdateGateway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListGateways(ctx context.Context, in *ListGatewaysRequest, opts ...grpc.CallOption) (*ListGatewaysResponse, error) {
+	out := new(ListGatewaysResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.AdminService/ListGateways", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListRegistrations(ctx context.Context, in *ListRegistrationsRequest, opts ...grpc.CallOption) (*ListRegistrationsResponse, error) {
+	out := new(ListRegistrationsResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.AdminService/ListRegistrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations should embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	// Start is used to start the service.
+	Start(context.Context, *StartRequest) (*StartResponse, error)
+	// UpdateGateway allows to update the list of gateway addresses,
+	// similar to the Start rpc, but after the service already started.
+	UpdateGateway(context.Context, *UpdateGatewayRequest) (*UpdateGatewayResponse, error)
+	// ListGateways returns the connectivity status of the gateways,
+	// including the ones the service keeps reconnecting to.
+	ListGateways(context.Context, *ListGatewaysRequest) (*ListGatewaysResponse, error)
+	// ListRegistrations returns the challenges registered in a round,
+	// with the provenance of their verification.
+	ListRegistrations(context.Context, *ListRegistrationsRequest) (*ListRegistrationsResponse, error)
+}
+
+// UnimplementedAdminServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) Start(context.Context, *StartRequest) (*StartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateGateway(context.Context, *UpdateGatewayRequest) (*UpdateGatewayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGateway not implemented")
+}
+func (UnimplementedAdminServiceServer) ListGateways(context.Context, *ListGatewaysRequest) (*ListGatewaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGateways not implemented")
+}
+func (UnimplementedAdminServiceServer) ListRegistrations(context.Context, *ListRegistrationsRequest) (*ListRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegistrations not implemented")
+}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.api.v1.AdminService/Start",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Start(ctx, req.(*StartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGatewayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.api.v1.AdminService/UpdateGateway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateGateway(ctx, req.(*UpdateGatewayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListGateways_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListGateways(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.api.v1.AdminService/ListGateways",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListGateways(ctx, req.(*ListGatewaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.api.v1.AdminService/ListRegistrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListRegistrations(ctx, req.(*ListRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpc.api.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Start",
+			Handler:    _AdminService_Start_Handler,
+		},
+		{
+			MethodName: "UpdateGateway",
+			Handler:    _AdminService_UpdateGateway_Handler,
+		},
+		{
+			MethodName: "ListGateways",
+			Handler:    _AdminService_ListGateways_Handler,
+		},
+		{
+			MethodName: "ListRegistrations",
+			Handler:    _AdminService_ListRegistrations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/api/v1/api.proto",
+}
+
+// CoreServiceClient is the client API for CoreService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CoreServiceClient interface {
+	// Prove generates a proof for the given statement,
+	// adding leaves to the proving tree until the deadline.
+	Prove(ctx context.Context, in *ProveRequest, opts ...grpc.CallOption) (*ProveResponse, error)
+}
+
+type coreServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCoreServiceClient(cc grpc.ClientConnInterface) CoreServiceClient {
+	return &coreServiceClient{cc}
+}
+
+func (c *coreServiceClient) Prove(ctx context.Context, in *ProveRequest, opts ...grpc.CallOption) (*ProveResponse, error) {
+	out := new(ProveResponse)
+	err := c.cc.Invoke(ctx, "/rpc.api.v1.CoreService/Prove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoreServiceServer is the server API for CoreService service.
+// All implementations should embed UnimplementedCoreServiceServer
+// for forward compatibility
+type CoreServiceServer interface {
+	// Prove generates a proof for the given statement,
+	// adding leaves to the proving tree until the deadline.
+	Prove(context.Context, *ProveRequest) (*ProveResponse, error)
+}
+
+// UnimplementedCoreServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedCoreServiceServer struct {
+}
+
+func (UnimplementedCoreServiceServer) Prove(context.Context, *ProveRequest) (*ProveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prove not implemented")
+}
+
+// UnsafeCoreServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CoreServiceServer will
+// result in compilation errors.
+type UnsafeCoreServiceServer interface {
+	mustEmbedUnimplementedCoreServiceServer()
+}
+
+func RegisterCoreServiceServer(s grpc.ServiceRegistrar, srv CoreServiceServer) {
+	s.RegisterService(&CoreService_ServiceDesc, srv)
+}
+
+func _CoreService_Prove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServiceServer).Prove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpc.api.v1.CoreService/Prove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServiceServer).Prove(ctx, req.(*ProveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CoreService_ServiceDesc is the grpc.ServiceDesc for CoreService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CoreService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "rpc.api.v1.CoreService",
+	HandlerType: (*CoreServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Prove",
+			Handler:    _CoreService_Prove_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/api/v1/api.proto",
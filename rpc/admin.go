@@ -0,0 +1,171 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/spacemeshos/poet/gateway"
+	"github.com/spacemeshos/poet/logging"
+	api "github.com/spacemeshos/poet/release/proto/go/rpc/api/v1"
+	"github.com/spacemeshos/poet/service"
+)
+
+// A compile time check to ensure that rpcService fully implements
+// the AdminServer gRPC rpc.
+var _ api.AdminServiceServer = (*rpcServer)(nil)
+
+func (r *rpcServer) Start(ctx context.Context, in *api.StartRequest) (*api.StartResponse, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.s.Started() {
+		return nil, service.ErrAlreadyStarted
+	}
+
+	if len(in.GatewayAddresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one gateway address is required")
+	}
+	connAcks := uint(in.ConnAcksThreshold)
+	if connAcks < 1 {
+		connAcks = 1
+	}
+
+	creds, err := r.cfg.GtwTLS.Credentials()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gateway TLS credentials: %w", err)
+	}
+	gtwConnCtx, cancel := context.WithTimeout(ctx, r.cfg.GtwConnTimeout)
+	defer cancel()
+	gtwManager := gateway.NewManager(gtwConnCtx, in.GatewayAddresses, connAcks, gateway.WithTransportCredentials(creds))
+	defer func() {
+		if err := gtwManager.Close(); err != nil {
+			logging.FromContext(ctx).Warn("failed to close GRPC connections", zap.Error(err))
+		}
+	}()
+
+	quorum := uint(in.VerifierQuorum)
+	if quorum == 0 {
+		quorum = r.cfg.Service.VerifierQuorum
+	}
+	verifier, err := service.CreateChallengeVerifier(gtwManager, quorum, r.verifierCache)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create challenge verifier: %w", err)
+	}
+
+	if err = r.s.Start(ctx, verifier); err != nil {
+		return nil, err
+	}
+	// Swap the new and old gateway managers.
+	// The old one will be closed in defer.
+	r.gtwManager, gtwManager = gtwManager, r.gtwManager
+
+	return &api.StartResponse{}, nil
+}
+
+func (r *rpcServer) UpdateGateway(ctx context.Context, in *api.UpdateGatewayRequest) (*api.UpdateGatewayResponse, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.s.Started() {
+		return nil, service.ErrNotStarted
+	}
+
+	if len(in.GatewayAddresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one gateway address is required")
+	}
+	connAcks := uint(in.ConnAcksThreshold)
+	if connAcks < 1 {
+		connAcks = 1
+	}
+
+	creds, err := r.cfg.GtwTLS.Credentials()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gateway TLS credentials: %w", err)
+	}
+	gtwConnCtx, cancel := context.WithTimeout(ctx, r.cfg.GtwConnTimeout)
+	defer cancel()
+	gtwManager := gateway.NewManager(gtwConnCtx, in.GatewayAddresses, connAcks, gateway.WithTransportCredentials(creds))
+	defer func() {
+		if err := gtwManager.Close(); err != nil {
+			logging.FromContext(ctx).Warn("failed to close GRPC connections", zap.Error(err))
+		}
+	}()
+
+	quorum := uint(in.VerifierQuorum)
+	if quorum == 0 {
+		quorum = r.cfg.Service.VerifierQuorum
+	}
+	verifier, err := service.CreateChallengeVerifier(gtwManager, quorum, r.verifierCache)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create challenge verifier: %w", err)
+	}
+
+	// Swap the new and old gateway managers.
+	// The old one will be closed in defer.
+	r.gtwManager, gtwManager = gtwManager, r.gtwManager
+	r.s.SetChallengeVerifier(verifier)
+
+	return &api.UpdateGatewayResponse{}, nil
+}
+
+// ListGateways implements api.ListGateways.
+func (r *rpcServer) ListGateways(ctx context.Context, in *api.ListGatewaysRequest) (*api.ListGatewaysResponse, error) {
+	r.Lock()
+	manager := r.gtwManager
+	r.Unlock()
+
+	gateways := manager.Status()
+	out := &api.ListGatewaysResponse{Ready: gateways.Ready, Required: uint32(gateways.Required)}
+	for _, gtw := range gateways.Gateways {
+		status := &api.GatewayStatus{
+			Address:   gtw.Address,
+			Connected: gtw.Connected,
+			State:     gtw.State,
+			Latency:   durationpb.New(gtw.Latency),
+			LastError: gtw.LastError,
+		}
+		if !gtw.LastErrorTime.IsZero() {
+			status.LastErrorTime = timestamppb.New(gtw.LastErrorTime)
+		}
+		out.Gateways = append(out.Gateways, status)
+	}
+	return out, nil
+}
+
+// ListRegistrations implements api.ListRegistrations.
+func (r *rpcServer) ListRegistrations(ctx context.Context, in *api.ListRegistrationsRequest) (*api.ListRegistrationsResponse, error) {
+	registrations, err := r.s.Registrations(ctx, in.RoundId)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out := new(api.ListRegistrationsResponse)
+	for _, registration := range registrations {
+		out.Registrations = append(out.Registrations, registrationToProto(registration))
+	}
+	return out, nil
+}
+
+func registrationToProto(registration *service.Registration) *api.Registration {
+	out := &api.Registration{
+		NodeId:      registration.NodeID,
+		Hash:        registration.Hash,
+		Challenge:   registration.Challenge,
+		Signature:   registration.Signature,
+		Verifiers:   registration.Verifiers,
+		SubmittedAt: timestamppb.New(registration.SubmittedAt),
+	}
+	if !registration.VerifiedAt.IsZero() {
+		out.VerifiedAt = timestamppb.New(registration.VerifiedAt)
+	}
+	return out
+}
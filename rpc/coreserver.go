@@ -0,0 +1,107 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"math"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/spacemeshos/poet/hash"
+	"github.com/spacemeshos/poet/logging"
+	"github.com/spacemeshos/poet/prover"
+	api "github.com/spacemeshos/poet/release/proto/go/rpc/api/v1"
+	"github.com/spacemeshos/poet/service"
+)
+
+// coreServer is a gRPC front end to a standalone prover worker.
+// It generates a single proof at a time.
+type coreServer struct {
+	datadir      string
+	memoryLayers uint
+	busy         chan struct{}
+}
+
+// A compile time check to ensure that coreServer fully implements
+// the CoreServiceServer gRPC rpc.
+var _ api.CoreServiceServer = (*coreServer)(nil)
+
+// NewCoreServer creates and returns a new instance of the coreServer.
+// Proving trees are cached under `datadir`, with their top `memoryLayers` layers held in-memory.
+func NewCoreServer(datadir string, memoryLayers uint) *coreServer {
+	return &coreServer{
+		datadir:      datadir,
+		memoryLayers: memoryLayers,
+		busy:         make(chan struct{}, 1),
+	}
+}
+
+// Prove implements api.Prove.
+func (c *coreServer) Prove(ctx context.Context, in *api.ProveRequest) (*api.ProveResponse, error) {
+	if len(in.Statement) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "statement is required")
+	}
+	if in.SecurityParam == 0 || in.SecurityParam > math.MaxUint8 {
+		return nil, status.Errorf(codes.InvalidArgument, "security param must be between 1 and %d", math.MaxUint8)
+	}
+	if in.Deadline == nil {
+		return nil, status.Error(codes.InvalidArgument, "deadline is required")
+	}
+	deadline := in.Deadline.AsTime()
+	if !deadline.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "deadline has already passed")
+	}
+	suite, err := hash.SuiteByName(in.HashSuite)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	select {
+	case c.busy <- struct{}{}:
+		defer func() { <-c.busy }()
+	default:
+		return nil, status.Error(codes.Unavailable, "worker is busy generating another proof")
+	}
+
+	logger := logging.FromContext(ctx)
+	tempdir, err := os.MkdirTemp(c.datadir, "proof-")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create proof directory: %v", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(tempdir); err != nil {
+			logger.Warn("failed to remove proof directory", zap.String("dir", tempdir), zap.Error(err))
+		}
+	}()
+
+	logger.Sugar().Infof("generating proof until %v...", deadline)
+	leaves, proof, err := prover.GenerateProofWithoutPersistency(
+		ctx,
+		tempdir,
+		suite.LabelHash(in.Statement, in.LabelDifficulty),
+		suite.MerkleHash(in.Statement),
+		suite.FiatShamir,
+		deadline,
+		uint8(in.SecurityParam),
+		service.MinMemoryLayer(time.Until(deadline), c.memoryLayers),
+	)
+	switch {
+	case errors.Is(err, prover.ErrShutdownRequested):
+		return nil, status.Error(codes.Canceled, "proof generation was interrupted")
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "failed to generate proof: %v", err)
+	}
+
+	return &api.ProveResponse{
+		Proof: &api.MerkleProof{
+			Root:         proof.Root,
+			ProvenLeaves: proof.ProvenLeaves,
+			ProofNodes:   proof.ProofNodes,
+		},
+		Leaves: leaves,
+	}, nil
+}
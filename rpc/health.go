@@ -0,0 +1,39 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Ready returns why the service is not ready to accept submissions, or nil if it is.
+// The service is ready once started with a challenge verifier, connected to a gateway unless it
+// verifies the challenges locally, storing the proofs, and processing its commands.
+func (r *rpcServer) Ready(ctx context.Context) error {
+	if !r.s.Started() {
+		return errors.New("service is not started")
+	}
+	if !r.proofsDb.Running() {
+		return errors.New("proofs database is not running")
+	}
+	if err := r.s.Ping(ctx); err != nil {
+		return fmt.Errorf("service is not responsive: %w", err)
+	}
+	if r.cfg.Service.LocalVerifier {
+		return nil
+	}
+
+	r.Lock()
+	manager := r.gtwManager
+	r.Unlock()
+	if status := manager.Status(); !status.Ready {
+		connected := 0
+		for _, gtw := range status.Gateways {
+			if gtw.Connected {
+				connected++
+			}
+		}
+		return fmt.Errorf("not enough gateways connected (%d/%d)", connected, status.Required)
+	}
+	return nil
+}
@@ -4,28 +4,46 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/spacemeshos/poet/config"
 	"github.com/spacemeshos/poet/gateway"
 	"github.com/spacemeshos/poet/gateway/challenge_verifier"
 	"github.com/spacemeshos/poet/logging"
+	"github.com/spacemeshos/poet/prover"
 	api "github.com/spacemeshos/poet/release/proto/go/rpc/api/v1"
 	"github.com/spacemeshos/poet/service"
+	"github.com/spacemeshos/poet/shared"
 )
 
+// maxProofWait is the longest WaitForProof waits for a proof, whatever the timeout requested.
+const maxProofWait = time.Hour
+
+// proofStoreGracePeriod is how long WaitForProof waits for the proof of a round the service won't execute,
+// in case the round just finished executing and its proof is being stored.
+const proofStoreGracePeriod = time.Second
+
+// proofChunkSize is the size of the members streamed in a chunk by WaitForProof.
+// A chunk holds at least one member.
+const proofChunkSize = 1 << 20
+
 // rpcServer is a gRPC, RPC front end to poet.
 type rpcServer struct {
 	proofsDb   *service.ProofsDatabase
 	s          *service.Service
 	gtwManager *gateway.Manager
-	cfg        config.Config
+	// verifierCache is shared by the challenge verifiers of the successive gateways.
+	verifierCache *challenge_verifier.Cache
+	cfg           config.Config
 	sync.Mutex
 }
 
@@ -34,93 +52,22 @@ type rpcServer struct {
 var _ api.PoetServiceServer = (*rpcServer)(nil)
 
 // NewServer creates and returns a new instance of the rpcServer.
-func NewServer(svc *service.Service, proofsDb *service.ProofsDatabase, gtwManager *gateway.Manager, cfg config.Config) *rpcServer {
+func NewServer(
+	svc *service.Service,
+	proofsDb *service.ProofsDatabase,
+	gtwManager *gateway.Manager,
+	verifierCache *challenge_verifier.Cache,
+	cfg config.Config,
+) *rpcServer {
 	return &rpcServer{
-		proofsDb:   proofsDb,
-		s:          svc,
-		cfg:        cfg,
-		gtwManager: gtwManager,
+		proofsDb:      proofsDb,
+		s:             svc,
+		cfg:           cfg,
+		gtwManager:    gtwManager,
+		verifierCache: verifierCache,
 	}
 }
 
-func (r *rpcServer) Start(ctx context.Context, in *api.StartRequest) (*api.StartResponse, error) {
-	r.Lock()
-	defer r.Unlock()
-
-	if r.s.Started() {
-		return nil, service.ErrAlreadyStarted
-	}
-
-	connAcks := uint(in.ConnAcksThreshold)
-	if connAcks < 1 {
-		connAcks = 1
-	}
-
-	gtwConnCtx, cancel := context.WithTimeout(ctx, r.cfg.GtwConnTimeout)
-	defer cancel()
-	gtwManager, err := gateway.NewManager(gtwConnCtx, in.GatewayAddresses, connAcks)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		if err := gtwManager.Close(); err != nil {
-			logging.FromContext(ctx).Warn("failed to close GRPC connections", zap.Error(err))
-		}
-	}()
-
-	verifier, err := service.CreateChallengeVerifier(gtwManager.Connections())
-	if err != nil {
-		return nil, fmt.Errorf("failed to create challenge verifier: %w", err)
-	}
-
-	if err = r.s.Start(ctx, verifier); err != nil {
-		return nil, err
-	}
-	// Swap the new and old gateway managers.
-	// The old one will be closed in defer.
-	r.gtwManager, gtwManager = gtwManager, r.gtwManager
-
-	return &api.StartResponse{}, nil
-}
-
-func (r *rpcServer) UpdateGateway(ctx context.Context, in *api.UpdateGatewayRequest) (*api.UpdateGatewayResponse, error) {
-	r.Lock()
-	defer r.Unlock()
-
-	if !r.s.Started() {
-		return nil, service.ErrNotStarted
-	}
-
-	connAcks := uint(in.ConnAcksThreshold)
-	if connAcks < 1 {
-		connAcks = 1
-	}
-
-	gtwConnCtx, cancel := context.WithTimeout(ctx, r.cfg.GtwConnTimeout)
-	defer cancel()
-	gtwManager, err := gateway.NewManager(gtwConnCtx, in.GatewayAddresses, connAcks)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		if err := gtwManager.Close(); err != nil {
-			logging.FromContext(ctx).Warn("failed to close GRPC connections", zap.Error(err))
-		}
-	}()
-
-	verifier, err := service.CreateChallengeVerifier(gtwManager.Connections())
-	if err != nil {
-		return nil, fmt.Errorf("failed to create challenge verifier: %w", err)
-	}
-
-	// Swap the new and old gateway managers.
-	// The old one will be closed in defer.
-	r.gtwManager, gtwManager = gtwManager, r.gtwManager
-	r.s.SetChallengeVerifier(verifier)
-
-	return &api.UpdateGatewayResponse{}, nil
-}
-
 // Submit implements api.Submit.
 func (r *rpcServer) Submit(ctx context.Context, in *api.SubmitRequest) (*api.SubmitResponse, error) {
 	result, err := r.s.Submit(ctx, in.Challenge, in.Signature)
@@ -129,6 +76,8 @@ func (r *rpcServer) Submit(ctx context.Context, in *api.SubmitRequest) (*api.Sub
 		return nil, status.Error(codes.FailedPrecondition, "cannot submit a challenge because poet service is not started")
 	case errors.Is(err, challenge_verifier.ErrChallengeInvalid):
 		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, challenge_verifier.ErrDisagreement):
+		return nil, status.Error(codes.Aborted, "gateways disagree on the challenge, consider retrying")
 	case errors.Is(err, challenge_verifier.ErrCouldNotVerify):
 		return nil, status.Error(codes.Unavailable, "failed to verify the challenge, consider retrying")
 	case err != nil:
@@ -174,21 +123,375 @@ func (r *rpcServer) GetProof(ctx context.Context, in *api.GetProofRequest) (*api
 	case errors.Is(err, service.ErrNotFound):
 		return nil, status.Error(codes.NotFound, "proof not found")
 	case err == nil:
-		out := api.GetProofResponse{
-			Proof: &api.PoetProof{
-				Proof: &api.MerkleProof{
-					Root:         proof.Root,
-					ProvenLeaves: proof.ProvenLeaves,
-					ProofNodes:   proof.ProofNodes,
-				},
-				Members: proof.Members,
-				Leaves:  proof.NumLeaves,
+		return proofToProto(proof), nil
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+}
+
+// WaitForProof implements api.WaitForProof.
+func (r *rpcServer) WaitForProof(in *api.WaitForProofRequest, stream api.PoetService_WaitForProofServer) error {
+	timeout := maxProofWait
+	if in.Timeout != nil {
+		if err := in.Timeout.CheckValid(); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		if t := in.Timeout.AsDuration(); t < timeout {
+			timeout = t
+		}
+	}
+	// The proof of a round the service won't execute is only waited for in case it is being stored.
+	producible := r.producible(stream.Context(), in.RoundId)
+	if !producible && proofStoreGracePeriod < timeout {
+		timeout = proofStoreGracePeriod
+	}
+	ctx, cancel := context.WithTimeout(stream.Context(), timeout)
+	defer cancel()
+
+	proof, err := r.proofsDb.Wait(ctx, in.RoundId)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded) && stream.Context().Err() == nil:
+		if !producible {
+			return status.Error(codes.NotFound, "round not found")
+		}
+		return status.Error(codes.DeadlineExceeded, "proof is not ready yet")
+	case ctx.Err() != nil:
+		return status.FromContextError(ctx.Err()).Err()
+	case err != nil:
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	out := proofToProto(proof)
+	members := out.Proof.Members
+	out.Proof.Members = nil
+	if err := stream.Send(&api.WaitForProofResponse{Chunk: &api.WaitForProofResponse_Proof{Proof: out}}); err != nil {
+		return err
+	}
+	for len(members) > 0 {
+		n, size := 0, 0
+		for ; n < len(members) && (n == 0 || size+len(members[n]) <= proofChunkSize); n++ {
+			size += len(members[n])
+		}
+		chunk := &api.ProofMembers{Members: members[:n]}
+		if err := stream.Send(&api.WaitForProofResponse{Chunk: &api.WaitForProofResponse_Members{Members: chunk}}); err != nil {
+			return err
+		}
+		members = members[n:]
+	}
+	return nil
+}
+
+// producible tells whether the service may still produce the proof of a round:
+// whether the round is open, executing or to be opened.
+// Any round may be produced before the service is started.
+func (r *rpcServer) producible(ctx context.Context, roundID string) bool {
+	info, err := r.s.Info(ctx)
+	if err != nil {
+		return true
+	}
+	if info.OpenRoundID == roundID || slices.Contains(info.ExecutingRoundsIds, roundID) {
+		return true
+	}
+	epoch, err := strconv.ParseUint(roundID, 10, 32)
+	if err != nil {
+		return false
+	}
+	open, err := strconv.ParseUint(info.OpenRoundID, 10, 32)
+	return err != nil || epoch > open
+}
+
+func proofToProto(proof *shared.ProofMessage) *api.GetProofResponse {
+	out := &api.GetProofResponse{
+		Proof: &api.PoetProof{
+			Proof: &api.MerkleProof{
+				Root:         proof.Root,
+				ProvenLeaves: proof.ProvenLeaves,
+				ProofNodes:   proof.ProofNodes,
 			},
-			Pubkey: proof.ServicePubKey,
+			Members:         proof.Members,
+			Leaves:          proof.NumLeaves,
+			HashSuite:       proof.HashSuite,
+			SecurityParam:   uint32(proof.SecurityParam),
+			LabelDifficulty: proof.LabelDifficulty,
+		},
+		Pubkey:    proof.ServicePubKey,
+		Signature: proof.Signature,
+		Version:   uint32(proof.Version),
+		Epoch:     proof.Epoch,
+		Statement: proof.Statement,
+	}
+	if proof.Version != 0 {
+		out.RoundStart = timestamppb.New(time.Unix(int64(proof.RoundStart), 0))
+		out.RoundEnd = timestamppb.New(time.Unix(int64(proof.RoundEnd), 0))
+	}
+	return out
+}
+
+// GetMembershipProof implements api.PoetServer.
+func (r *rpcServer) GetMembershipProof(ctx context.Context, in *api.GetMembershipProofRequest) (*api.GetMembershipProofResponse, error) {
+	if len(in.Challenge) == 0 && len(in.NodeId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "either challenge or node ID must be provided")
+	}
+	if info, err := r.s.Info(ctx); err == nil {
+		if info.OpenRoundID == in.RoundId || slices.Contains(info.ExecutingRoundsIds, in.RoundId) {
+			return nil, status.Error(codes.Unavailable, "round is not finished yet")
 		}
+	}
 
-		return &out, nil
+	proof, err := r.proofsDb.GetMembershipProof(ctx, in.RoundId, in.Challenge, in.NodeId)
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "proof not found")
+	case errors.Is(err, service.ErrMemberNotFound):
+		return nil, status.Error(codes.NotFound, "member not found")
+	case err == nil:
+		return &api.GetMembershipProofResponse{
+			Proof: &api.MembershipProof{
+				Index: int32(proof.Index),
+				Root:  proof.Root,
+				Proof: proof.Proof,
+			},
+		}, nil
 	default:
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 }
+
+// FindRegistration implements api.PoetServer.
+func (r *rpcServer) FindRegistration(ctx context.Context, in *api.FindRegistrationRequest) (*api.FindRegistrationResponse, error) {
+	if len(in.Challenge) == 0 && len(in.NodeId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "either challenge or node ID must be provided")
+	}
+	locations, err := r.proofsDb.Find(ctx, in.Challenge, in.NodeId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(locations) == 0 {
+		return nil, status.Error(codes.NotFound, "registration not found")
+	}
+
+	out := &api.FindRegistrationResponse{}
+	for _, location := range locations {
+		out.Locations = append(out.Locations, &api.MemberLocation{RoundId: location.RoundID, Index: location.Index})
+	}
+	return out, nil
+}
+
+// GetRegistration implements api.PoetServer.
+func (r *rpcServer) GetRegistration(ctx context.Context, in *api.GetRegistrationRequest) (*api.GetRegistrationResponse, error) {
+	if len(in.NodeId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "node ID must be provided")
+	}
+	registrations, err := r.s.NodeRegistrations(ctx, in.NodeId)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out := &api.GetRegistrationResponse{Registered: len(registrations) > 0}
+	for _, registration := range registrations {
+		state := api.RoundInfo_STATE_OPEN
+		if registration.Status == service.RoundExecuting {
+			state = api.RoundInfo_STATE_EXECUTING
+		}
+		out.Registrations = append(out.Registrations, &api.RoundRegistration{
+			RoundId:  registration.RoundID,
+			State:    state,
+			Hash:     registration.Hash,
+			RoundEnd: timestamppb.New(registration.RoundEnd),
+		})
+	}
+	return out, nil
+}
+
+// SubscribeEvents implements api.PoetServer.
+func (r *rpcServer) SubscribeEvents(in *api.SubscribeEventsRequest, stream api.PoetService_SubscribeEventsServer) error {
+	ctx := stream.Context()
+	events, err := r.s.Subscribe(ctx)
+	switch {
+	case errors.Is(err, service.ErrStopped):
+		return status.Error(codes.Unavailable, "service stopped")
+	case err != nil:
+		return status.FromContextError(err).Err()
+	}
+
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				if ctx.Err() != nil {
+					return status.FromContextError(ctx.Err()).Err()
+				}
+				return status.Error(codes.Unavailable, "events subscription was closed")
+			}
+			if err := stream.Send(&api.SubscribeEventsResponse{Event: roundEventToProto(ev)}); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+func roundEventToProto(ev service.Event) *api.RoundEvent {
+	out := &api.RoundEvent{
+		RoundId: ev.RoundID,
+		Time:    timestamppb.New(ev.Time),
+		Leaves:  ev.NumLeaves,
+	}
+	switch ev.Type {
+	case service.RoundOpened:
+		out.Type = api.RoundEvent_TYPE_ROUND_OPENED
+	case service.ExecutionStarted:
+		out.Type = api.RoundEvent_TYPE_EXECUTION_STARTED
+	case service.CheckpointPersisted:
+		out.Type = api.RoundEvent_TYPE_CHECKPOINT_PERSISTED
+	case service.ProofReady:
+		out.Type = api.RoundEvent_TYPE_PROOF_READY
+	case service.RoundFailed:
+		out.Type = api.RoundEvent_TYPE_ROUND_FAILED
+	case service.ProgressReported:
+		out.Type = api.RoundEvent_TYPE_PROGRESS_REPORTED
+		out.Progress = progressToProto(ev.Progress)
+	}
+	if ev.Err != nil {
+		out.Error = ev.Err.Error()
+	}
+	return out
+}
+
+// ListRounds implements api.PoetServer.
+func (r *rpcServer) ListRounds(ctx context.Context, in *api.ListRoundsRequest) (*api.ListRoundsResponse, error) {
+	rounds, err := r.s.Rounds(ctx)
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	proofs, err := r.proofsDb.List(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	out := &api.ListRoundsResponse{}
+	for _, proof := range proofs {
+		if slices.IndexFunc(rounds, func(info *service.RoundInfo) bool { return info.ID == proof.RoundID }) >= 0 {
+			continue
+		}
+		info, err := r.executedRoundInfo(proof)
+		if err != nil {
+			logging.FromContext(ctx).Warn("skipping executed round", zap.String("round", proof.RoundID), zap.Error(err))
+			continue
+		}
+		out.Rounds = append(out.Rounds, roundInfoToProto(info))
+	}
+	for _, info := range rounds {
+		out.Rounds = append(out.Rounds, roundInfoToProto(info))
+	}
+	slices.SortFunc(out.Rounds, func(a, b *api.RoundInfo) bool { return a.Epoch < b.Epoch })
+	return out, nil
+}
+
+// GetRound implements api.PoetServer.
+func (r *rpcServer) GetRound(ctx context.Context, in *api.GetRoundRequest) (*api.GetRoundResponse, error) {
+	rounds, err := r.s.Rounds(ctx)
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	if i := slices.IndexFunc(rounds, func(info *service.RoundInfo) bool { return info.ID == in.RoundId }); i >= 0 {
+		return &api.GetRoundResponse{Round: roundInfoToProto(rounds[i])}, nil
+	}
+
+	proof, err := r.proofsDb.Summary(ctx, in.RoundId)
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		return nil, status.Error(codes.NotFound, "round not found")
+	case err != nil:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	info, err := r.executedRoundInfo(proof)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &api.GetRoundResponse{Round: roundInfoToProto(info)}, nil
+}
+
+// GetRoundProgress implements api.PoetServer.
+func (r *rpcServer) GetRoundProgress(ctx context.Context, in *api.GetRoundProgressRequest) (*api.GetRoundProgressResponse, error) {
+	progress, err := r.s.RoundProgress(ctx, in.RoundId)
+	switch {
+	case errors.Is(err, service.ErrRoundNotExecuting):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, status.FromContextError(err).Err()
+	case progress == nil:
+		return nil, status.Error(codes.Unavailable, "no progress reported yet")
+	}
+	return &api.GetRoundProgressResponse{Progress: progressToProto(progress)}, nil
+}
+
+// executedRoundInfo describes an executed round from the summary of its proof.
+// The execution window of proofs that predate the proof envelope is derived from the current config.
+func (r *rpcServer) executedRoundInfo(proof *service.ProofSummary) (*service.RoundInfo, error) {
+	if proof.Version != 0 {
+		return &service.RoundInfo{
+			ID:               proof.RoundID,
+			Epoch:            proof.Epoch,
+			Status:           service.RoundExecuted,
+			ExecutionStarted: time.Unix(int64(proof.RoundStart), 0),
+			ExecutionEnd:     time.Unix(int64(proof.RoundEnd), 0),
+			Members:          proof.Members,
+			Leaves:           proof.NumLeaves,
+		}, nil
+	}
+	epoch, err := strconv.ParseUint(proof.RoundID, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid round id %q: %w", proof.RoundID, err)
+	}
+	start, end := r.s.ExecutionWindow(uint32(epoch))
+	return &service.RoundInfo{
+		ID:               proof.RoundID,
+		Epoch:            uint32(epoch),
+		Status:           service.RoundExecuted,
+		ExecutionStarted: start,
+		ExecutionEnd:     end,
+		Members:          proof.Members,
+		Leaves:           proof.NumLeaves,
+	}, nil
+}
+
+func roundInfoToProto(info *service.RoundInfo) *api.RoundInfo {
+	out := &api.RoundInfo{
+		RoundId: info.ID,
+		Epoch:   info.Epoch,
+		Members: uint64(info.Members),
+		Leaves:  info.Leaves,
+	}
+	switch info.Status {
+	case service.RoundOpen:
+		out.State = api.RoundInfo_STATE_OPEN
+	case service.RoundExecuting:
+		out.State = api.RoundInfo_STATE_EXECUTING
+	case service.RoundExecuted:
+		out.State = api.RoundInfo_STATE_EXECUTED
+	}
+	if !info.Opened.IsZero() {
+		out.Opened = timestamppb.New(info.Opened)
+	}
+	if !info.ExecutionStarted.IsZero() {
+		out.ExecutionStarted = timestamppb.New(info.ExecutionStarted)
+	}
+	if !info.ExecutionEnd.IsZero() {
+		out.ExecutionEnd = timestamppb.New(info.ExecutionEnd)
+	}
+	return out
+}
+
+func progressToProto(progress *prover.Progress) *api.RoundProgress {
+	return &api.RoundProgress{
+		Leaves:          progress.Leaves,
+		LeavesPerSecond: progress.LeavesPerSecond,
+		ProjectedLeaves: progress.ProjectedLeaves,
+		TimeLeft:        durationpb.New(progress.TimeLeft),
+	}
+}
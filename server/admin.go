@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/spacemeshos/poet/config"
+	"github.com/spacemeshos/poet/logging"
+	api "github.com/spacemeshos/poet/release/proto/go/rpc/api/v1"
+)
+
+// adminMethodPrefix prefixes the full method names of the admin RPCs.
+var adminMethodPrefix = "/" + api.AdminService_ServiceDesc.ServiceName + "/"
+
+const bearerPrefix = "Bearer "
+
+// adminAuthenticator authenticates the callers of the admin RPCs, with a bearer token
+// or the identity of a client certificate verified by mutual TLS,
+// and writes every admin call to the audit log.
+type adminAuthenticator struct {
+	token      []byte
+	identities map[string]struct{}
+	audit      *zap.Logger
+	auditFile  io.Closer
+}
+
+// validateAdminConfig rejects the admin listeners that would not serve the admin RPCs as configured.
+func validateAdminConfig(cfg config.Config) error {
+	switch {
+	case cfg.Admin.Listener != nil && !cfg.Admin.Enabled():
+		return errors.New("the admin listener requires an admin token or identity")
+	case cfg.Admin.TLS.Enabled() && cfg.Admin.Listener == nil:
+		return errors.New("the admin TLS config requires a separate admin listener")
+	}
+	return nil
+}
+
+func newAdminAuthenticator(cfg config.Config) (*adminAuthenticator, error) {
+	if len(cfg.Admin.Identities) != 0 && cfg.AdminTLS().ClientCA == "" {
+		return nil, errors.New("admin identities require mutual TLS, configure a client CA")
+	}
+	if cfg.Admin.AuditLog == "" {
+		return nil, errors.New("the audit log of the admin RPCs is not configured")
+	}
+
+	a := &adminAuthenticator{
+		token:      []byte(cfg.Admin.Token),
+		identities: make(map[string]struct{}),
+	}
+	for _, identity := range cfg.Admin.Identities {
+		if identity != "" {
+			a.identities[identity] = struct{}{}
+		}
+	}
+	a.audit, a.auditFile = logging.NewAudit(cfg.Admin.AuditLog)
+	return a, nil
+}
+
+func (a *adminAuthenticator) Close() error {
+	_ = a.audit.Sync()
+	return a.auditFile.Close()
+}
+
+// interceptor authenticates and audits the calls to the admin RPCs, passing the other calls through.
+func (a *adminAuthenticator) interceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !strings.HasPrefix(info.FullMethod, adminMethodPrefix) {
+		return handler(ctx, req)
+	}
+
+	fields := []zap.Field{zap.String("method", info.FullMethod)}
+	if peer, ok := peer.FromContext(ctx); ok {
+		fields = append(fields, zap.Stringer("peer", peer.Addr))
+	}
+	if msg, ok := req.(fmt.Stringer); ok {
+		fields = append(fields, zap.Stringer("request", msg))
+	}
+
+	caller, err := a.authenticate(ctx)
+	if err != nil {
+		a.audit.Warn("denied", append(fields, zap.Stringer("code", status.Code(err)))...)
+		return nil, err
+	}
+
+	resp, err := handler(ctx, req)
+	a.audit.Info("called", append(fields,
+		zap.String("caller", caller),
+		zap.Stringer("code", status.Code(err)),
+		zap.Error(err),
+	)...)
+	return resp, err
+}
+
+// authenticate returns how the caller authenticated: with the token, or with the certificate of an identity.
+func (a *adminAuthenticator) authenticate(ctx context.Context) (string, error) {
+	if len(a.token) != 0 {
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, value := range md.Get("authorization") {
+			if !strings.HasPrefix(value, bearerPrefix) {
+				continue
+			}
+			if subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(value, bearerPrefix)), a.token) == 1 {
+				return "token", nil
+			}
+		}
+	}
+
+	if peer, ok := peer.FromContext(ctx); ok && len(a.identities) != 0 {
+		if info, ok := peer.AuthInfo.(credentials.TLSInfo); ok && len(info.State.VerifiedChains) != 0 {
+			cert := info.State.VerifiedChains[0][0]
+			for _, name := range append([]string{cert.Subject.CommonName}, cert.DNSNames...) {
+				if _, ok := a.identities[name]; ok {
+					return "certificate:" + name, nil
+				}
+			}
+		}
+	}
+
+	return "", status.Error(codes.Unauthenticated, "admin RPCs require a valid bearer token or client certificate")
+}
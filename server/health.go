@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	api "github.com/spacemeshos/poet/release/proto/go/rpc/api/v1"
+)
+
+const (
+	// readinessCheckInterval is how often the readiness of the service is checked for the gRPC health service.
+	readinessCheckInterval = time.Second
+
+	// readinessTimeout bounds a readiness check, which waits for the service to process a command.
+	readinessTimeout = 5 * time.Second
+)
+
+// readinessChecker returns why the service is not ready to accept submissions, or nil if it is.
+type readinessChecker interface {
+	Ready(ctx context.Context) error
+}
+
+func checkReadiness(ctx context.Context, checker readinessChecker) error {
+	ctx, cancel := context.WithTimeout(ctx, readinessTimeout)
+	defer cancel()
+	return checker.Ready(ctx)
+}
+
+// watchReadiness reports the readiness of the service as the serving status of the PoetService
+// in the health server, until the context is canceled.
+// The overall status of the server stays serving while it is up.
+func watchReadiness(ctx context.Context, server *health.Server, checker readinessChecker) {
+	ticker := time.NewTicker(readinessCheckInterval)
+	defer ticker.Stop()
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+		if err := checkReadiness(ctx, checker); err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+		server.SetServingStatus(api.PoetService_ServiceDesc.ServiceName, status)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// handleHealthz tells that the server is up.
+func handleHealthz(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	_, _ = io.WriteString(w, "ok\n")
+}
+
+// handleReadyz tells whether the service is ready to accept submissions, and why not otherwise.
+func handleReadyz(checker readinessChecker) func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		if err := checkReadiness(r.Context(), checker); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = io.WriteString(w, "ok\n")
+	}
+}
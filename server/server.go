@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
@@ -13,12 +15,16 @@ import (
 	"github.com/google/uuid"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/hashicorp/go-multierror"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
 
 	"github.com/spacemeshos/poet/config"
 	"github.com/spacemeshos/poet/gateway"
@@ -26,29 +32,57 @@ import (
 	api "github.com/spacemeshos/poet/release/proto/go/rpc/api/v1"
 	"github.com/spacemeshos/poet/rpc"
 	"github.com/spacemeshos/poet/service"
+	"github.com/spacemeshos/poet/shared"
+	"github.com/spacemeshos/poet/webhook"
 )
 
+// proxyBufferSize is the size of the buffer of the in-process connections of the REST proxy.
+const proxyBufferSize = 1 << 20
+
 type Server struct {
 	svc          *service.Service
 	cfg          config.Config
 	rpcListener  net.Listener
 	restListener net.Listener
+	// adminListener serves the admin RPCs if they are not served on the RPC listener.
+	adminListener net.Listener
 }
 
 func New(ctx context.Context, cfg config.Config) (*Server, error) {
+	if _, err := os.Stat(cfg.DataDir); os.IsNotExist(err) {
+		if err := os.Mkdir(cfg.DataDir, 0o700); err != nil {
+			return nil, err
+		}
+	}
+
 	rpcListener, err := net.Listen(cfg.RPCListener.Network(), cfg.RPCListener.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to listen: %v", err)
 	}
 
+	// In core mode poet is a standalone prover worker,
+	// serving neither the REST proxy nor the rounds service.
+	if cfg.CoreServiceMode {
+		return &Server{
+			cfg:         cfg,
+			rpcListener: rpcListener,
+		}, nil
+	}
+
+	if err := validateAdminConfig(cfg); err != nil {
+		return nil, err
+	}
+
 	restListener, err := net.Listen(cfg.RESTListener.Network(), cfg.RESTListener.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to listen: %v", err)
 	}
 
-	if _, err := os.Stat(cfg.DataDir); os.IsNotExist(err) {
-		if err := os.Mkdir(cfg.DataDir, 0o700); err != nil {
-			return nil, err
+	var adminListener net.Listener
+	if cfg.Admin.Listener != nil {
+		adminListener, err = net.Listen(cfg.Admin.Listener.Network(), cfg.Admin.Listener.String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to listen: %v", err)
 		}
 	}
 
@@ -58,16 +92,22 @@ func New(ctx context.Context, cfg config.Config) (*Server, error) {
 	}
 
 	return &Server{
-		svc:          svc,
-		cfg:          cfg,
-		rpcListener:  rpcListener,
-		restListener: restListener,
+		svc:           svc,
+		cfg:           cfg,
+		rpcListener:   rpcListener,
+		restListener:  restListener,
+		adminListener: adminListener,
 	}, nil
 }
 
 func (s *Server) Close() error {
 	result := multierror.Append(nil, s.rpcListener.Close())
-	result = multierror.Append(result, s.restListener.Close())
+	if s.restListener != nil {
+		result = multierror.Append(result, s.restListener.Close())
+	}
+	if s.adminListener != nil {
+		result = multierror.Append(result, s.adminListener.Close())
+	}
 	return result
 }
 
@@ -75,8 +115,29 @@ func (s *Server) RpcAddr() net.Addr {
 	return s.rpcListener.Addr()
 }
 
+func (s *Server) RestAddr() net.Addr {
+	return s.restListener.Addr()
+}
+
+// AdminAddr returns the address serving the admin RPCs, which is the RPC address
+// if they are not served on a separate listener.
+func (s *Server) AdminAddr() net.Addr {
+	if s.adminListener != nil {
+		return s.adminListener.Addr()
+	}
+	return s.rpcListener.Addr()
+}
+
 // Start starts the RPC server.
 func (s *Server) Start(ctx context.Context) error {
+	if s.cfg.CoreServiceMode {
+		return s.startCore(ctx)
+	}
+	// Without gateways, the service is started by the Start admin RPC.
+	if !s.cfg.Service.LocalVerifier && len(s.cfg.Service.GatewayAddresses) == 0 && !s.cfg.Admin.Enabled() {
+		return errors.New("no gateway is configured and the admin RPCs starting the service are disabled")
+	}
+
 	ctx, stop := context.WithCancel(ctx)
 	defer stop()
 	serverGroup, ctx := errgroup.WithContext(ctx)
@@ -100,8 +161,28 @@ func (s *Server) Start(ctx context.Context) error {
 		}),
 	}
 
+	var proofsDbOpts []service.ProofsDatabaseOption
+	if s.cfg.Webhook.Enabled() {
+		notifier, err := webhook.Open(filepath.Join(s.cfg.DataDir, "webhookOutbox"), *s.cfg.Webhook)
+		if err != nil {
+			return fmt.Errorf("failed to open webhook outbox: %w", err)
+		}
+		defer notifier.Close()
+		serverGroup.Go(func() error {
+			return notifier.Run(ctx)
+		})
+		proofsDbOpts = append(proofsDbOpts, service.WithOnStored(func(proof *shared.ProofMessage) error {
+			return notifier.Notify(webhook.Notification{
+				RoundID: proof.RoundID,
+				Root:    hex.EncodeToString(proof.Root),
+				Leaves:  proof.NumLeaves,
+				Members: len(proof.Members),
+			})
+		}))
+	}
+
 	proofsDbPath := filepath.Join(s.cfg.DataDir, "proofs")
-	proofsDb, err := service.NewProofsDatabase(proofsDbPath, s.svc.ProofsChan())
+	proofsDb, err := service.NewProofsDatabase(proofsDbPath, s.svc.ProofsChan(), proofsDbOpts...)
 	if err != nil {
 		return fmt.Errorf("failed to create proofs DB: %w", err)
 	}
@@ -113,42 +194,148 @@ func (s *Server) Start(ctx context.Context) error {
 		return s.svc.Run(ctx)
 	})
 
-	gtwConnCtx, cancel := context.WithTimeout(ctx, s.cfg.GtwConnTimeout)
-	defer cancel()
-	gtwManager, err := gateway.NewManager(gtwConnCtx, s.cfg.Service.GatewayAddresses, s.cfg.Service.ConnAcksThreshold)
-	if err == nil {
-		verifier, err := service.CreateChallengeVerifier(gtwManager.Connections())
+	verifierCache, err := service.OpenChallengeVerifierCache(s.cfg.Service, s.cfg.DataDir)
+	if err != nil {
+		return fmt.Errorf("failed to open challenge verifier cache: %w", err)
+	}
+	defer verifierCache.Close()
+
+	var gtwManager *gateway.Manager
+	if s.cfg.Service.LocalVerifier {
+		verifier, err := service.CreateLocalChallengeVerifier(s.cfg.Service.AllowedNodeKeys, verifierCache)
 		if err != nil {
-			if err := gtwManager.Close(); err != nil {
-				logger.Warn("failed to close GRPC connections", zap.Error(err))
-			}
 			return fmt.Errorf("failed to create challenge verifier: %w", err)
 		}
 		if err := s.svc.Start(ctx, verifier); err != nil {
 			return err
 		}
-	} else {
-		logger.Info("Service not starting, waiting for start request", zap.Error(err))
+		logger.Info("Service started with the local challenge verifier")
 		gtwManager = &gateway.Manager{}
+	} else {
+		gtwConnCtx, cancel := context.WithTimeout(ctx, s.cfg.GtwConnTimeout)
+		defer cancel()
+		creds, err := s.cfg.GtwTLS.Credentials()
+		if err != nil {
+			return fmt.Errorf("failed to load gateway TLS credentials: %w", err)
+		}
+		if len(s.cfg.Service.GatewayAddresses) == 0 {
+			logger.Info("Service not starting, waiting for start request")
+			gtwManager = &gateway.Manager{}
+		} else {
+			gtwManager = gateway.NewManager(gtwConnCtx, s.cfg.Service.GatewayAddresses, s.cfg.Service.ConnAcksThreshold, gateway.WithTransportCredentials(creds))
+			verifier, err := service.CreateChallengeVerifier(gtwManager, s.cfg.Service.VerifierQuorum, verifierCache)
+			if err != nil {
+				if err := gtwManager.Close(); err != nil {
+					logger.Warn("failed to close GRPC connections", zap.Error(err))
+				}
+				return fmt.Errorf("failed to create challenge verifier: %w", err)
+			}
+			if err := s.svc.Start(ctx, verifier); err != nil {
+				return err
+			}
+		}
 	}
 
-	rpcServer := rpc.NewServer(s.svc, proofsDb, gtwManager, s.cfg)
-	grpcServer = grpc.NewServer(options...)
+	restListener := s.restListener
+	rpcOptions := options
+	if s.cfg.TLS.Enabled() {
+		creds, restTLS, err := s.cfg.TLS.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load TLS config: %w", err)
+		}
+		rpcOptions = append(rpcOptions, grpc.Creds(creds))
+		restListener = tls.NewListener(restListener, restTLS)
+	}
+	var adminOptions []grpc.ServerOption
+	if s.cfg.Admin.Enabled() {
+		auth, err := newAdminAuthenticator(s.cfg)
+		if err != nil {
+			return fmt.Errorf("failed to configure the admin RPCs: %w", err)
+		}
+		defer auth.Close()
+		if s.adminListener == nil {
+			rpcOptions = append(rpcOptions, grpc.ChainUnaryInterceptor(auth.interceptor))
+		} else {
+			// The admin listener serves its own TLS, so that it may require client certificates
+			// while the RPC listener doesn't.
+			adminOptions = append(append(adminOptions, options...), grpc.ChainUnaryInterceptor(auth.interceptor))
+			if adminTLS := s.cfg.AdminTLS(); adminTLS.Enabled() {
+				creds, _, err := adminTLS.Load()
+				if err != nil {
+					return fmt.Errorf("failed to load admin TLS config: %w", err)
+				}
+				adminOptions = append(adminOptions, grpc.Creds(creds))
+			}
+		}
+	}
+
+	rpcServer := rpc.NewServer(s.svc, proofsDb, gtwManager, verifierCache, s.cfg)
+	grpcServer = grpc.NewServer(rpcOptions...)
+	// The REST proxy reaches the RPC server in-process, bypassing the TLS of the RPC listener.
+	// It does not serve the admin RPCs.
+	proxyServer := grpc.NewServer(options...)
+	proxyListener := bufconn.Listen(proxyBufferSize)
+	grpcServers := []*grpc.Server{grpcServer, proxyServer}
 
-	api.RegisterPoetServiceServer(grpcServer, rpcServer)
+	for _, srv := range grpcServers {
+		api.RegisterPoetServiceServer(srv, rpcServer)
+	}
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	serverGroup.Go(func() error {
+		watchReadiness(ctx, healthServer, rpcServer)
+		return nil
+	})
 	proxyRegstr = append(proxyRegstr, api.RegisterPoetServiceHandlerFromEndpoint)
 
+	switch {
+	case !s.cfg.Admin.Enabled():
+		logger.Warn("admin RPCs are disabled, configure an admin token or identity to enable them")
+	case s.adminListener != nil:
+		adminServer := grpc.NewServer(adminOptions...)
+		api.RegisterAdminServiceServer(adminServer, rpcServer)
+		grpcServers = append(grpcServers, adminServer)
+		serverGroup.Go(func() error {
+			logger.Sugar().Infof("admin RPC server listening on %s", s.adminListener.Addr())
+			return adminServer.Serve(s.adminListener)
+		})
+	default:
+		api.RegisterAdminServiceServer(grpcServer, rpcServer)
+	}
+
 	// Start the gRPC server listening for HTTP/2 connections.
 	serverGroup.Go(func() error {
 		logger.Sugar().Infof("RPC server listening on %s", s.rpcListener.Addr())
 		return grpcServer.Serve(s.rpcListener)
 	})
 
+	serverGroup.Go(func() error {
+		return proxyServer.Serve(proxyListener)
+	})
+
 	// Start the REST proxy for the gRPC server above.
-	mux := proxy.NewServeMux()
+	mux := proxy.NewServeMux(proxy.WithMarshalerOption(mimeEventStream, newSSEMarshaler()))
+	proxyDialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return proxyListener.DialContext(ctx)
+		}),
+	}
 	for _, r := range proxyRegstr {
-		err := r(ctx, mux, s.rpcListener.Addr().String(), []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())})
-		if err != nil {
+		if err := r(ctx, mux, "bufconn", proxyDialOpts); err != nil {
+			return err
+		}
+	}
+	metrics := promhttp.Handler()
+	paths := map[string]proxy.HandlerFunc{
+		"/metrics": func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			metrics.ServeHTTP(w, r)
+		},
+		"/healthz": handleHealthz,
+		"/readyz":  handleReadyz(rpcServer),
+	}
+	for path, handler := range paths {
+		if err := mux.HandlePath(http.MethodGet, path, handler); err != nil {
 			return err
 		}
 	}
@@ -156,7 +343,7 @@ func (s *Server) Start(ctx context.Context) error {
 	server := &http.Server{Handler: mux}
 	serverGroup.Go(func() error {
 		logger.Sugar().Infof("REST proxy starts listening on %s", s.restListener.Addr())
-		err := server.Serve(s.restListener)
+		err := server.Serve(restListener)
 		if errors.Is(err, http.ErrServerClosed) {
 			return nil
 		}
@@ -165,11 +352,49 @@ func (s *Server) Start(ctx context.Context) error {
 
 	// Wait for the server to shut down gracefully
 	<-ctx.Done()
-	grpcServer.GracefulStop()
+	healthServer.Shutdown()
+	for _, srv := range grpcServers {
+		srv.GracefulStop()
+	}
 	server.Shutdown(ctx)
 	return serverGroup.Wait()
 }
 
+// startCore starts the RPC server of a standalone prover worker.
+func (s *Server) startCore(ctx context.Context) error {
+	logger := logging.FromContext(ctx)
+
+	proofsDir := filepath.Join(s.cfg.DataDir, "core")
+	if err := os.MkdirAll(proofsDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create proofs directory: %w", err)
+	}
+
+	options := []grpc.ServerOption{grpc.UnaryInterceptor(loggerInterceptor(logger))}
+	if s.cfg.TLS.Enabled() {
+		creds, _, err := s.cfg.TLS.Load()
+		if err != nil {
+			return fmt.Errorf("failed to load TLS config: %w", err)
+		}
+		options = append(options, grpc.Creds(creds))
+	}
+	grpcServer := grpc.NewServer(options...)
+	api.RegisterCoreServiceServer(grpcServer, rpc.NewCoreServer(proofsDir, s.cfg.CoreService.MemoryLayers))
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(api.CoreService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	var eg errgroup.Group
+	eg.Go(func() error {
+		logger.Sugar().Infof("Core RPC server listening on %s", s.rpcListener.Addr())
+		return grpcServer.Serve(s.rpcListener)
+	})
+
+	<-ctx.Done()
+	healthServer.Shutdown()
+	grpcServer.GracefulStop()
+	return eg.Wait()
+}
+
 // loggerInterceptor returns UnaryServerInterceptor handler to log all RPC server incoming requests.
 func loggerInterceptor(logger *zap.Logger) func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
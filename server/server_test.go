@@ -4,28 +4,58 @@ package server_test
 // its GRPC API.
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 	"time"
 
 	pb "github.com/spacemeshos/api/release/go/spacemesh/v1"
 	"github.com/spacemeshos/merkle-tree"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slices"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/spacemeshos/poet/config"
 	"github.com/spacemeshos/poet/gateway"
+	"github.com/spacemeshos/poet/gateway/challenge_verifier"
 	"github.com/spacemeshos/poet/hash"
 	api "github.com/spacemeshos/poet/release/proto/go/rpc/api/v1"
 	"github.com/spacemeshos/poet/server"
 	"github.com/spacemeshos/poet/shared"
+	"github.com/spacemeshos/poet/tlsconfig"
+	"github.com/spacemeshos/poet/tlsconfig/tlsconfigtest"
 	"github.com/spacemeshos/poet/verifier"
+	"github.com/spacemeshos/poet/webhook"
 )
 
-const randomHost = "localhost:0"
+const (
+	randomHost = "localhost:0"
+	adminToken = "admin-token"
+)
 
 type gatewayService struct {
 	pb.UnimplementedGatewayServiceServer
@@ -72,6 +102,23 @@ func spawnPoet(ctx context.Context, t *testing.T, cfg config.Config) (*server.Se
 	return srv, api.NewPoetServiceClient(conn)
 }
 
+// dialAdmin returns a client of the admin RPCs served at the given address.
+func dialAdmin(t *testing.T, addr net.Addr, opts ...grpc.DialOption) api.AdminServiceClient {
+	t.Helper()
+	if len(opts) == 0 {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	conn, err := grpc.DialContext(context.Background(), addr.String(), opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return api.NewAdminServiceClient(conn)
+}
+
+// withToken authenticates the admin RPC calls with the given bearer token.
+func withToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
 // Test poet service startup.
 func TestPoetStart(t *testing.T) {
 	t.Parallel()
@@ -85,6 +132,7 @@ func TestPoetStart(t *testing.T) {
 	cfg.RawRPCListener = randomHost
 	cfg.RawRESTListener = randomHost
 	cfg.Service.GatewayAddresses = []string{gtw}
+	cfg.Admin.Token = adminToken
 
 	srv, client := spawnPoet(ctx, t, *cfg)
 
@@ -97,10 +145,324 @@ func TestPoetStart(t *testing.T) {
 	req.NoError(err)
 	req.Equal("0", resp.OpenRoundId)
 
+	admin := dialAdmin(t, srv.AdminAddr())
+	gateways, err := admin.ListGateways(withToken(context.Background(), adminToken), &api.ListGatewaysRequest{})
+	req.NoError(err)
+	req.Len(gateways.Gateways, 1)
+	req.Equal(gtw, gateways.Gateways[0].Address)
+	req.True(gateways.Gateways[0].Connected)
+	req.True(gateways.Ready)
+
+	cancel()
+	req.NoError(eg.Wait())
+}
+
+// Test the health and readiness of the service, which is ready once started with enough connected gateways.
+func TestHealth(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cfg := config.DefaultConfig()
+	cfg.PoetDir = t.TempDir()
+	cfg.RawRPCListener = randomHost
+	cfg.RawRESTListener = randomHost
+	cfg.Service.GatewayAddresses = []string{"localhost:1"}
+	cfg.GtwConnTimeout = 100 * time.Millisecond
+	cfg.Admin.Token = adminToken
+
+	srv, _ := spawnPoet(ctx, t, *cfg)
+	var eg errgroup.Group
+	eg.Go(func() error {
+		return srv.Start(ctx)
+	})
+
+	conn, err := grpc.DialContext(context.Background(), srv.RpcAddr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	req.NoError(err)
+	t.Cleanup(func() { conn.Close() })
+	health := healthpb.NewHealthClient(conn)
+	poetService := api.PoetService_ServiceDesc.ServiceName
+	servingStatus := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := health.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			return healthpb.HealthCheckResponse_UNKNOWN
+		}
+		return resp.Status
+	}
+	get := func(path string) (int, string) {
+		resp, err := http.Get(fmt.Sprintf("http://%s%s", srv.RestAddr(), path))
+		req.NoError(err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		req.NoError(err)
+		return resp.StatusCode, string(body)
+	}
+
+	// Up and started, but not ready as the gateway is unreachable.
+	req.Eventually(func() bool {
+		return servingStatus("") == healthpb.HealthCheckResponse_SERVING &&
+			servingStatus(poetService) == healthpb.HealthCheckResponse_NOT_SERVING
+	}, 5*time.Second, 10*time.Millisecond)
+	code, _ := get("/healthz")
+	req.Equal(http.StatusOK, code)
+	code, body := get("/readyz")
+	req.Equal(http.StatusServiceUnavailable, code)
+	req.Contains(body, "not enough gateways connected (0/1)")
+
+	admin := dialAdmin(t, srv.AdminAddr())
+	gateways, err := admin.ListGateways(withToken(context.Background(), adminToken), &api.ListGatewaysRequest{})
+	req.NoError(err)
+	req.False(gateways.Ready)
+	req.Len(gateways.Gateways, 1)
+	req.False(gateways.Gateways[0].Connected)
+
+	// Ready once a gateway is connected.
+	_, err = admin.UpdateGateway(
+		withToken(context.Background(), adminToken),
+		&api.UpdateGatewayRequest{GatewayAddresses: []string{spawnMockGateway(t)}},
+	)
+	req.NoError(err)
+	code, _ = get("/readyz")
+	req.Equal(http.StatusOK, code)
+	req.Eventually(func() bool {
+		return servingStatus(poetService) == healthpb.HealthCheckResponse_SERVING
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	req.NoError(eg.Wait())
+}
+
+// Test serving the RPC and REST APIs over mutual TLS.
+func TestMutualTLS(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	gtw := spawnMockGateway(t)
+	ca := tlsconfigtest.NewTestCA(t)
+	serverCert, serverKey := ca.Issue(t, "server")
+	clientCert, clientKey := ca.Issue(t, "client")
+	adminCert, adminKey := ca.Issue(t, "admin")
+
+	cfg := config.DefaultConfig()
+	cfg.PoetDir = t.TempDir()
+	cfg.RawRPCListener = randomHost
+	cfg.RawRESTListener = randomHost
+	cfg.Service.GatewayAddresses = []string{gtw}
+	cfg.TLS = &tlsconfig.Server{Cert: serverCert, Key: serverKey, ClientCA: ca.File}
+	cfg.Admin.Identities = []string{"admin"}
+
+	_, err := config.SetupConfig(cfg)
+	req.NoError(err)
+	srv, err := server.New(context.Background(), *cfg)
+	req.NoError(err)
+	var eg errgroup.Group
+	eg.Go(func() error {
+		return srv.Start(ctx)
+	})
+
+	// RPC
+	creds, err := (&tlsconfig.Client{Enabled: true, CA: ca.File, Cert: clientCert, Key: clientKey}).Credentials()
+	req.NoError(err)
+	conn, err := grpc.DialContext(context.Background(), srv.RpcAddr().String(), grpc.WithTransportCredentials(creds))
+	req.NoError(err)
+	t.Cleanup(func() { conn.Close() })
+	_, err = api.NewPoetServiceClient(conn).GetInfo(context.Background(), &api.GetInfoRequest{})
+	req.NoError(err)
+
+	insecureConn, err := grpc.DialContext(context.Background(), srv.RpcAddr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	req.NoError(err)
+	t.Cleanup(func() { insecureConn.Close() })
+	_, err = api.NewPoetServiceClient(insecureConn).GetInfo(context.Background(), &api.GetInfoRequest{})
+	req.Equal(codes.Unavailable, status.Code(err))
+
+	// Admin RPCs require the certificate of an admin identity.
+	_, err = api.NewAdminServiceClient(conn).ListGateways(context.Background(), &api.ListGatewaysRequest{})
+	req.Equal(codes.Unauthenticated, status.Code(err))
+	adminCreds, err := (&tlsconfig.Client{Enabled: true, CA: ca.File, Cert: adminCert, Key: adminKey}).Credentials()
+	req.NoError(err)
+	_, err = dialAdmin(t, srv.AdminAddr(), grpc.WithTransportCredentials(adminCreds)).
+		ListGateways(context.Background(), &api.ListGatewaysRequest{})
+	req.NoError(err)
+
+	// REST
+	caPEM, err := os.ReadFile(ca.File)
+	req.NoError(err)
+	roots := x509.NewCertPool()
+	req.True(roots.AppendCertsFromPEM(caPEM))
+	certificate, err := tls.LoadX509KeyPair(clientCert, clientKey)
+	req.NoError(err)
+	url := fmt.Sprintf("https://%s/v1/info", srv.RestAddr())
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: []tls.Certificate{certificate}}}}
+	resp, err := client.Get(url)
+	req.NoError(err)
+	resp.Body.Close()
+	req.Equal(http.StatusOK, resp.StatusCode)
+
+	client = &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: roots}}}
+	_, err = client.Get(url)
+	req.Error(err)
+
+	cancel()
+	req.NoError(eg.Wait())
+}
+
+// Test authenticating and auditing the admin RPCs served on a separate listener.
+func TestAdminAuthentication(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cfg := config.DefaultConfig()
+	cfg.PoetDir = t.TempDir()
+	cfg.RawRPCListener = randomHost
+	cfg.RawRESTListener = randomHost
+	cfg.Service.GatewayAddresses = []string{spawnMockGateway(t)}
+	cfg.Admin.RawListener = randomHost
+	cfg.Admin.Token = adminToken
+
+	srv, client := spawnPoet(ctx, t, *cfg)
+	var eg errgroup.Group
+	eg.Go(func() error {
+		return srv.Start(ctx)
+	})
+	req.NotEqual(srv.RpcAddr(), srv.AdminAddr())
+
+	admin := dialAdmin(t, srv.AdminAddr())
+	_, err := admin.ListGateways(context.Background(), &api.ListGatewaysRequest{})
+	req.Equal(codes.Unauthenticated, status.Code(err))
+	_, err = admin.ListGateways(withToken(context.Background(), "invalid"), &api.ListGatewaysRequest{})
+	req.Equal(codes.Unauthenticated, status.Code(err))
+	_, err = admin.ListGateways(withToken(context.Background(), adminToken), &api.ListGatewaysRequest{})
+	req.NoError(err)
+
+	// The admin RPCs are not served on the RPC listener, nor the public RPCs on the admin listener.
+	_, err = dialAdmin(t, srv.RpcAddr()).ListGateways(withToken(context.Background(), adminToken), &api.ListGatewaysRequest{})
+	req.Equal(codes.Unimplemented, status.Code(err))
+	_, err = client.GetInfo(context.Background(), &api.GetInfoRequest{})
+	req.NoError(err)
+
+	cancel()
+	req.NoError(eg.Wait())
+
+	// Every admin call is audited.
+	audit, err := os.ReadFile(filepath.Join(cfg.PoetDir, "logs", "audit.log"))
+	req.NoError(err)
+	var entries []map[string]any
+	for _, line := range bytes.Split(bytes.TrimSpace(audit), []byte("\n")) {
+		var entry map[string]any
+		req.NoError(json.Unmarshal(line, &entry))
+		entries = append(entries, entry)
+	}
+	req.Len(entries, 3)
+	for _, entry := range entries {
+		req.Equal("/rpc.api.v1.AdminService/ListGateways", entry["method"])
+	}
+	req.Equal("denied", entries[0]["msg"])
+	req.Equal("Unauthenticated", entries[1]["code"])
+	req.Equal("called", entries[2]["msg"])
+	req.Equal("token", entries[2]["caller"])
+	req.Equal("OK", entries[2]["code"])
+}
+
+// Test requiring mutual TLS on the admin listener only.
+func TestAdminMutualTLS(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ca := tlsconfigtest.NewTestCA(t)
+	serverCert, serverKey := ca.Issue(t, "server")
+	clientCert, clientKey := ca.Issue(t, "client")
+	adminCert, adminKey := ca.Issue(t, "admin")
+
+	cfg := config.DefaultConfig()
+	cfg.PoetDir = t.TempDir()
+	cfg.RawRPCListener = randomHost
+	cfg.RawRESTListener = randomHost
+	cfg.Service.GatewayAddresses = []string{spawnMockGateway(t)}
+	cfg.TLS = &tlsconfig.Server{Cert: serverCert, Key: serverKey}
+	cfg.Admin.RawListener = randomHost
+	cfg.Admin.Identities = []string{"admin"}
+	cfg.Admin.TLS = &tlsconfig.Server{Cert: serverCert, Key: serverKey, ClientCA: ca.File}
+
+	_, err := config.SetupConfig(cfg)
+	req.NoError(err)
+	srv, err := server.New(context.Background(), *cfg)
+	req.NoError(err)
+	var eg errgroup.Group
+	eg.Go(func() error {
+		return srv.Start(ctx)
+	})
+
+	// The public RPCs don't require a client certificate.
+	creds, err := (&tlsconfig.Client{Enabled: true, CA: ca.File}).Credentials()
+	req.NoError(err)
+	conn, err := grpc.DialContext(context.Background(), srv.RpcAddr().String(), grpc.WithTransportCredentials(creds))
+	req.NoError(err)
+	t.Cleanup(func() { conn.Close() })
+	_, err = api.NewPoetServiceClient(conn).GetInfo(context.Background(), &api.GetInfoRequest{})
+	req.NoError(err)
+
+	// The admin RPCs require the certificate of an admin identity.
+	_, err = dialAdmin(t, srv.AdminAddr(), grpc.WithTransportCredentials(creds)).
+		ListGateways(context.Background(), &api.ListGatewaysRequest{})
+	req.Equal(codes.Unavailable, status.Code(err))
+	clientCreds, err := (&tlsconfig.Client{Enabled: true, CA: ca.File, Cert: clientCert, Key: clientKey}).Credentials()
+	req.NoError(err)
+	_, err = dialAdmin(t, srv.AdminAddr(), grpc.WithTransportCredentials(clientCreds)).
+		ListGateways(context.Background(), &api.ListGatewaysRequest{})
+	req.Equal(codes.Unauthenticated, status.Code(err))
+	adminCreds, err := (&tlsconfig.Client{Enabled: true, CA: ca.File, Cert: adminCert, Key: adminKey}).Credentials()
+	req.NoError(err)
+	_, err = dialAdmin(t, srv.AdminAddr(), grpc.WithTransportCredentials(adminCreds)).
+		ListGateways(context.Background(), &api.ListGatewaysRequest{})
+	req.NoError(err)
+
 	cancel()
 	req.NoError(eg.Wait())
 }
 
+// Test rejecting the admin configs that would leave the admin RPCs unusable.
+func TestInvalidAdminConfig(t *testing.T) {
+	t.Parallel()
+	newConfig := func() *config.Config {
+		cfg := config.DefaultConfig()
+		cfg.PoetDir = t.TempDir()
+		cfg.RawRPCListener = randomHost
+		cfg.RawRESTListener = randomHost
+		cfg.Admin.RawListener = randomHost
+		_, err := config.SetupConfig(cfg)
+		require.NoError(t, err)
+		return cfg
+	}
+
+	t.Run("admin listener without authentication", func(t *testing.T) {
+		t.Parallel()
+		_, err := server.New(context.Background(), *newConfig())
+		require.ErrorContains(t, err, "admin token or identity")
+	})
+	t.Run("admin TLS without admin listener", func(t *testing.T) {
+		t.Parallel()
+		cfg := newConfig()
+		cfg.Admin.Listener = nil
+		cfg.Admin.Token = adminToken
+		cfg.Admin.TLS = &tlsconfig.Server{ClientCA: "ca.pem"}
+		_, err := server.New(context.Background(), *cfg)
+		require.ErrorContains(t, err, "separate admin listener")
+	})
+	t.Run("no gateway without admin RPCs", func(t *testing.T) {
+		t.Parallel()
+		cfg := newConfig()
+		cfg.Admin.Listener = nil
+		srv, err := server.New(context.Background(), *cfg)
+		require.NoError(t, err)
+		t.Cleanup(func() { srv.Close() })
+		require.ErrorContains(t, srv.Start(context.Background()), "admin RPCs starting the service are disabled")
+	})
+}
+
 // Test submitting a challenge followed by proof generation and getting the proof via GRPC.
 func TestSubmitAndGetProof(t *testing.T) {
 	t.Parallel()
@@ -118,6 +480,31 @@ func TestSubmitAndGetProof(t *testing.T) {
 	cfg.RawRPCListener = randomHost
 	cfg.RawRESTListener = randomHost
 	cfg.Service.GatewayAddresses = []string{gtw}
+	cfg.Service.HashSuite = hash.SHA3
+	cfg.Service.SecurityParam = 20
+	cfg.Service.LabelDifficulty = 10
+	cfg.Admin.Token = adminToken
+
+	notifications := make(chan webhook.Notification, 100)
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil || r.Header.Get(webhook.SignatureHeader) != webhook.Sign([]byte("secret"), body) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var notification webhook.Notification
+		if err := json.Unmarshal(body, &notification); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		select {
+		case notifications <- notification:
+		default:
+		}
+	}))
+	t.Cleanup(hook.Close)
+	cfg.Webhook.URLs = []string{hook.URL}
+	cfg.Webhook.Secret = "secret"
 
 	srv, client := spawnPoet(ctx, t, *cfg)
 
@@ -131,23 +518,73 @@ func TestSubmitAndGetProof(t *testing.T) {
 	req.NoError(err)
 	req.Equal([]byte("hash"), resp.Hash)
 
+	admin := dialAdmin(t, srv.AdminAddr())
+	registrations, err := admin.ListRegistrations(withToken(context.Background(), adminToken), &api.ListRegistrationsRequest{RoundId: resp.RoundId})
+	req.NoError(err)
+	req.Len(registrations.Registrations, 1)
+	req.Equal([]byte("nodeID"), registrations.Registrations[0].NodeId)
+	req.Equal([]string{gtw}, registrations.Registrations[0].Verifiers)
+	req.NotNil(registrations.Registrations[0].VerifiedAt)
+
 	roundEnd := resp.RoundEnd.AsDuration()
 	req.NotZero(roundEnd)
 
-	// Wait for round to end
-	<-time.After(roundEnd)
+	// The registration is recorded in the round
+	registration, err := client.GetRegistration(context.Background(), &api.GetRegistrationRequest{NodeId: []byte("nodeID")})
+	req.NoError(err)
+	req.True(registration.Registered)
+	req.Len(registration.Registrations, 1)
+	req.Equal(resp.RoundId, registration.Registrations[0].RoundId)
+	req.Equal([]byte("hash"), registration.Registrations[0].Hash)
+	req.WithinDuration(time.Now().Add(roundEnd), registration.Registrations[0].RoundEnd.AsTime(), time.Second)
+
+	registration, err = client.GetRegistration(context.Background(), &api.GetRegistrationRequest{NodeId: []byte("unknown")})
+	req.NoError(err)
+	req.False(registration.Registered)
+
+	// Waiting for the proof times out before the round ends
+	stream, err := client.WaitForProof(context.Background(), &api.WaitForProofRequest{
+		RoundId: resp.RoundId,
+		Timeout: durationpb.New(10 * time.Millisecond),
+	})
+	req.NoError(err)
+	_, err = stream.Recv()
+	req.Equal(codes.DeadlineExceeded, status.Code(err))
+
+	// Wait for the proof, streamed in chunks
+	stream, err = client.WaitForProof(context.Background(), &api.WaitForProofRequest{
+		RoundId: resp.RoundId,
+		Timeout: durationpb.New(roundEnd + 10*time.Second),
+	})
+	req.NoError(err)
+	chunk, err := stream.Recv()
+	req.NoError(err)
+	waited := chunk.GetProof()
+	req.NotNil(waited)
+	req.Empty(waited.Proof.Members)
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		req.NoError(err)
+		waited.Proof.Members = append(waited.Proof.Members, chunk.GetMembers().GetMembers()...)
+	}
 
 	// Query for the proof
-	var proof *api.GetProofResponse
-	req.Eventually(func() bool {
-		proof, err = client.GetProof(context.Background(), &api.GetProofRequest{RoundId: resp.RoundId})
-		return err == nil
-	}, time.Second, time.Millisecond*100)
+	proof, err := client.GetProof(context.Background(), &api.GetProofRequest{RoundId: resp.RoundId})
+	req.NoError(err)
+	req.True(proto.Equal(proof, waited))
+
+	// Waiting for the proof of a round that is never executed fails
+	stream, err = client.WaitForProof(context.Background(), &api.WaitForProofRequest{RoundId: "unknown"})
+	req.NoError(err)
+	_, err = stream.Recv()
+	req.Equal(codes.NotFound, status.Code(err))
 
 	req.NotZero(proof.Proof.Leaves)
 	req.Len(proof.Proof.Members, 1)
 	req.Contains(proof.Proof.Members, []byte("hash"))
-	cancel()
 
 	merkleProof := shared.MerkleProof{
 		Root:         proof.Proof.Proof.Root,
@@ -158,10 +595,204 @@ func TestSubmitAndGetProof(t *testing.T) {
 	root, err := calcRoot(proof.Proof.Members)
 	req.NoError(err)
 
-	labelHashFunc := hash.GenLabelHashFunc(root)
-	merkleHashFunc := hash.GenMerkleHashFunc(root)
-	req.NoError(verifier.Validate(merkleProof, labelHashFunc, merkleHashFunc, proof.Proof.Leaves, shared.T))
+	req.Equal(hash.SHA3, proof.Proof.HashSuite)
+	req.EqualValues(20, proof.Proof.SecurityParam)
+	req.EqualValues(10, proof.Proof.LabelDifficulty)
+	suite, err := hash.SuiteByName(proof.Proof.HashSuite)
+	req.NoError(err)
+	securityParam := uint8(proof.Proof.SecurityParam)
+	req.NoError(verifier.Validate(merkleProof, suite.LabelHash(root, proof.Proof.LabelDifficulty), suite.MerkleHash(root), suite.FiatShamir, proof.Proof.Leaves, securityParam))
+	req.EqualValues(shared.ProofMessageVersion, proof.Version)
+	req.Equal(root, proof.Statement)
+	req.Equal(resp.RoundId, strconv.FormatUint(uint64(proof.Epoch), 10))
+	req.Equal(proof.RoundStart.AsTime().Add(cfg.Service.EpochDuration), proof.RoundEnd.AsTime())
+	req.NoError(verifier.ValidateSignature(&shared.ProofMessage{
+		Version: uint8(proof.Version),
+		Proof: shared.Proof{
+			MerkleProof: merkleProof,
+			Members:     proof.Proof.Members,
+			NumLeaves:   proof.Proof.Leaves,
+		},
+		ServicePubKey:   proof.Pubkey,
+		RoundID:         resp.RoundId,
+		Epoch:           proof.Epoch,
+		RoundStart:      uint64(proof.RoundStart.AsTime().Unix()),
+		RoundEnd:        uint64(proof.RoundEnd.AsTime().Unix()),
+		Statement:       proof.Statement,
+		Signature:       proof.Signature,
+		HashSuite:       proof.Proof.HashSuite,
+		SecurityParam:   securityParam,
+		LabelDifficulty: proof.Proof.LabelDifficulty,
+	}))
+
+	// Query for the membership proof, by challenge and by node ID
+	for _, in := range []*api.GetMembershipProofRequest{
+		{RoundId: resp.RoundId, Challenge: []byte("hash")},
+		{RoundId: resp.RoundId, NodeId: []byte("nodeID")},
+	} {
+		membership, err := client.GetMembershipProof(context.Background(), in)
+		req.NoError(err)
+		req.Equal(root, membership.Proof.Root)
+		req.NoError(verifier.ValidateMembership([]byte("hash"), uint64(membership.Proof.Index), membership.Proof.Root, membership.Proof.Proof))
+	}
+
+	_, err = client.GetMembershipProof(context.Background(), &api.GetMembershipProofRequest{RoundId: resp.RoundId, NodeId: []byte("unknown")})
+	req.Equal(codes.NotFound, status.Code(err))
+
+	// Find the round of the registration, by challenge and by node ID
+	for _, in := range []*api.FindRegistrationRequest{{Challenge: []byte("hash")}, {NodeId: []byte("nodeID")}} {
+		found, err := client.FindRegistration(context.Background(), in)
+		req.NoError(err)
+		req.Len(found.Locations, 1)
+		req.Equal(resp.RoundId, found.Locations[0].RoundId)
+		req.Zero(found.Locations[0].Index)
+	}
+
+	_, err = client.FindRegistration(context.Background(), &api.FindRegistrationRequest{NodeId: []byte("unknown")})
+	req.Equal(codes.NotFound, status.Code(err))
+
+	// Query for the round
+	round, err := client.GetRound(context.Background(), &api.GetRoundRequest{RoundId: resp.RoundId})
+	req.NoError(err)
+	req.Equal(api.RoundInfo_STATE_EXECUTED, round.Round.State)
+	req.EqualValues(1, round.Round.Members)
+	req.Equal(proof.Proof.Leaves, round.Round.Leaves)
+
+	rounds, err := client.ListRounds(context.Background(), &api.ListRoundsRequest{})
+	req.NoError(err)
+	req.True(slices.ContainsFunc(rounds.Rounds, func(r *api.RoundInfo) bool { return proto.Equal(r, round.Round) }))
+
+	// The webhook is notified of the proof
+	expected := webhook.Notification{
+		RoundID: resp.RoundId,
+		Root:    hex.EncodeToString(proof.Proof.Proof.Root),
+		Leaves:  proof.Proof.Leaves,
+		Members: 1,
+	}
+	req.Eventually(func() bool {
+		for {
+			select {
+			case notification := <-notifications:
+				if notification == expected {
+					return true
+				}
+			default:
+				return false
+			}
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Query the metrics
+	metricsResp, err := http.Get(fmt.Sprintf("http://%s/metrics", srv.RestAddr()))
+	req.NoError(err)
+	defer metricsResp.Body.Close()
+	req.Equal(http.StatusOK, metricsResp.StatusCode)
+	metrics, err := io.ReadAll(metricsResp.Body)
+	req.NoError(err)
+	req.Contains(string(metrics), fmt.Sprintf(`poet_service_submissions_total{result="submitted",round="%s"}`, resp.RoundId))
+	req.Contains(string(metrics), fmt.Sprintf(`poet_verifier_requests_total{result="valid",verifier="%s"}`, gtw))
+	req.Contains(string(metrics), fmt.Sprintf(`poet_verifier_cache_lookups_total{result="miss",verifier="%s"}`, gtw))
+	req.Contains(string(metrics), "poet_prover_proof_generation_duration_seconds_count")
+
+	cancel()
+	req.NoError(eg.Wait())
+}
+
+// Test submitting challenges verified locally, without a gateway.
+func TestSubmitWithLocalVerifier(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	req.NoError(err)
+	_, otherKey, err := ed25519.GenerateKey(nil)
+	req.NoError(err)
+
+	cfg := config.DefaultConfig()
+	cfg.PoetDir = t.TempDir()
+	cfg.RawRPCListener = randomHost
+	cfg.RawRESTListener = randomHost
+	cfg.Service.LocalVerifier = true
+	cfg.Service.AllowedNodeKeys = []string{hex.EncodeToString(pubKey)}
+
+	srv, client := spawnPoet(ctx, t, *cfg)
 
+	var eg errgroup.Group
+	eg.Go(func() error {
+		return srv.Start(ctx)
+	})
+
+	challenge := []byte("challenge")
+	resp, err := client.Submit(context.Background(), &api.SubmitRequest{
+		Challenge: challenge,
+		Signature: challenge_verifier.SignLocal(privKey, challenge),
+	})
+	req.NoError(err)
+	hash := sha256.Sum256(challenge)
+	req.Equal(hash[:], resp.Hash)
+
+	// Nodes that are not allowed are rejected.
+	_, err = client.Submit(context.Background(), &api.SubmitRequest{
+		Challenge: challenge,
+		Signature: challenge_verifier.SignLocal(otherKey, challenge),
+	})
+	req.Equal(codes.InvalidArgument, status.Code(err))
+
+	cancel()
+	req.NoError(eg.Wait())
+}
+
+// Test generating a proof with poet running as a standalone prover worker.
+func TestCoreProve(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cfg := config.DefaultConfig()
+	cfg.PoetDir = t.TempDir()
+	cfg.RawRPCListener = randomHost
+	cfg.CoreServiceMode = true
+
+	_, err := config.SetupConfig(cfg)
+	req.NoError(err)
+
+	srv, err := server.New(context.Background(), *cfg)
+	req.NoError(err)
+
+	var eg errgroup.Group
+	eg.Go(func() error {
+		return srv.Start(ctx)
+	})
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		srv.RpcAddr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	req.NoError(err)
+	t.Cleanup(func() { conn.Close() })
+	client := api.NewCoreServiceClient(conn)
+
+	statement := []byte("statement")
+	resp, err := client.Prove(context.Background(), &api.ProveRequest{
+		Statement:     statement,
+		Deadline:      timestamppb.New(time.Now().Add(100 * time.Millisecond)),
+		SecurityParam: 5,
+	})
+	req.NoError(err)
+	req.NotZero(resp.Leaves)
+
+	merkleProof := shared.MerkleProof{
+		Root:         resp.Proof.Root,
+		ProvenLeaves: resp.Proof.ProvenLeaves,
+		ProofNodes:   resp.Proof.ProofNodes,
+	}
+	req.NoError(verifier.Validate(merkleProof, hash.GenLabelHashFunc(statement), hash.GenMerkleHashFunc(statement), shared.FiatShamir, resp.Leaves, 5))
+
+	_, err = client.Prove(context.Background(), &api.ProveRequest{Statement: statement, SecurityParam: 5})
+	req.Equal(codes.InvalidArgument, status.Code(err))
+
+	cancel()
 	req.NoError(eg.Wait())
 }
 
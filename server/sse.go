@@ -0,0 +1,42 @@
+package server
+
+import (
+	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const mimeEventStream = "text/event-stream"
+
+// sseMarshaler streams the messages of server-streaming RPCs
+// as server-sent events to the REST clients accepting `text/event-stream`.
+type sseMarshaler struct {
+	proxy.JSONPb
+}
+
+func newSSEMarshaler() *sseMarshaler {
+	return &sseMarshaler{
+		JSONPb: proxy.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		},
+	}
+}
+
+// Marshal marshals v into the data field of an event.
+func (m *sseMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("data: "), data...), nil
+}
+
+// ContentType implements proxy.Marshaler.
+func (m *sseMarshaler) ContentType(interface{}) string {
+	return mimeEventStream
+}
+
+// Delimiter terminates each event with an empty line.
+func (m *sseMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
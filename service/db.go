@@ -3,22 +3,73 @@ package service
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/spacemeshos/go-scale"
+	"github.com/spacemeshos/merkle-tree"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 
 	"github.com/spacemeshos/poet/logging"
 	"github.com/spacemeshos/poet/shared"
 )
 
-var ErrNotFound = leveldb.ErrNotFound
+var (
+	ErrNotFound       = leveldb.ErrNotFound
+	ErrMemberNotFound = errors.New("member not found")
+)
+
+// pendingPrefix prefixes the keys marking the stored proofs whose onStored hooks did not all succeed yet:
+// pendingPrefix || round ID. Round IDs are numbers, so the markers are never mistaken for proofs.
+var pendingPrefix = []byte("pending/")
+
+func pendingKey(roundID string) []byte {
+	return append(append([]byte(nil), pendingPrefix...), roundID...)
+}
+
+// DefaultRetryInterval is how often the onStored hooks and the indexing of the proofs that failed are retried.
+const DefaultRetryInterval = time.Minute
 
 type ProofsDatabase struct {
 	db     *leveldb.DB
+	index  *proofsIndex
 	proofs <-chan shared.ProofMessage
+	// running tells whether Run is storing the proofs.
+	running atomic.Bool
+
+	// stored is closed, and replaced, whenever a proof is stored.
+	storedMu sync.Mutex
+	stored   chan struct{}
+
+	onStored []func(*shared.ProofMessage) error
+	// retryInterval is how often the onStored hooks and the indexing of the proofs that failed are retried.
+	retryInterval time.Duration
+}
+
+type ProofsDatabaseOption func(*ProofsDatabase)
+
+// WithRetryInterval sets how often the onStored hooks and the indexing of the proofs that failed are retried.
+func WithRetryInterval(interval time.Duration) ProofsDatabaseOption {
+	return func(db *ProofsDatabase) {
+		db.retryInterval = interval
+	}
+}
+
+// WithOnStored calls `onStored` with every proof once it is stored.
+// The proof is stored along with a marker that is cleared once all the hooks succeed, so that they
+// are called again, after a crash or until they succeed, and thus must be idempotent.
+func WithOnStored(onStored func(*shared.ProofMessage) error) ProofsDatabaseOption {
+	return func(db *ProofsDatabase) {
+		db.onStored = append(db.onStored, onStored)
+	}
 }
 
 func (db *ProofsDatabase) Get(ctx context.Context, roundID string) (*shared.ProofMessage, error) {
@@ -27,24 +78,182 @@ func (db *ProofsDatabase) Get(ctx context.Context, roundID string) (*shared.Proo
 		return nil, fmt.Errorf("get proof for %s from DB: %w", roundID, err)
 	}
 
-	proof := &shared.ProofMessage{}
-	if _, err := proof.DecodeScale(scale.NewDecoder(bytes.NewReader(data))); err != nil {
+	proof, err := deserializeProofMsg(data)
+	if err != nil {
 		return nil, fmt.Errorf("failed to get deserialize proof: %w", err)
 	}
 	return proof, nil
 }
 
-func NewProofsDatabase(dbPath string, proofs <-chan shared.ProofMessage) (*ProofsDatabase, error) {
+// Wait waits until the proof of the round is stored and returns it.
+func (db *ProofsDatabase) Wait(ctx context.Context, roundID string) (*shared.ProofMessage, error) {
+	for {
+		db.storedMu.Lock()
+		stored := db.stored
+		db.storedMu.Unlock()
+
+		proof, err := db.Get(ctx, roundID)
+		if !errors.Is(err, ErrNotFound) {
+			return proof, err
+		}
+		select {
+		case <-stored:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// notifyStored wakes up the callers of Wait.
+func (db *ProofsDatabase) notifyStored() {
+	db.storedMu.Lock()
+	defer db.storedMu.Unlock()
+	close(db.stored)
+	db.stored = make(chan struct{})
+}
+
+// ProofSummary describes a stored proof without the proof itself.
+type ProofSummary struct {
+	// Version is the version of the layout of the proof message.
+	// The fields after RoundID are zero for proofs of version 0, which predate them.
+	Version   uint8
+	RoundID   string
+	Members   int
+	NumLeaves uint64
+
+	Epoch      uint32
+	RoundStart uint64
+	RoundEnd   uint64
+}
+
+// Summary returns the summary of the proof of the given round.
+func (db *ProofsDatabase) Summary(ctx context.Context, roundID string) (*ProofSummary, error) {
+	data, err := db.db.Get([]byte(roundID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("get proof for %s from DB: %w", roundID, err)
+	}
+	summary, err := decodeProofSummary(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode proof for %s: %w", roundID, err)
+	}
+	return summary, nil
+}
+
+// List returns the summaries of the proofs of all the executed rounds.
+// The proofs that fail to decode are logged and skipped.
+func (db *ProofsDatabase) List(ctx context.Context) ([]*ProofSummary, error) {
+	logger := logging.FromContext(ctx).Named("proofs-db")
+	iter := db.db.NewIterator(nil, nil)
+	defer iter.Release()
+
+	var summaries []*ProofSummary
+	for iter.Next() {
+		if bytes.HasPrefix(iter.Key(), pendingPrefix) {
+			continue
+		}
+		summary, err := decodeProofSummary(iter.Value())
+		if err != nil {
+			logger.Warn("skipping proof that failed to decode", zap.ByteString("round", iter.Key()), zap.Error(err))
+			continue
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, iter.Error()
+}
+
+// Find returns the locations of a member in the stored proofs.
+// The member is looked up by its challenge if one is given, or by the ID of the node that registered it otherwise.
+func (db *ProofsDatabase) Find(ctx context.Context, challenge, nodeID []byte) ([]MemberLocation, error) {
+	locations, err := db.index.find(challenge, nodeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up the proofs index: %w", err)
+	}
+	return locations, nil
+}
+
+// MembershipProof is a Merkle proof of inclusion of a single member
+// in the tree whose root is the statement of a round.
+type MembershipProof struct {
+	Index uint64
+	Root  []byte
+	Proof [][]byte
+}
+
+// GetMembershipProof returns the membership proof of a member of the given round.
+// The member is looked up by its challenge if one is given, or by the ID of the node that registered it otherwise.
+func (db *ProofsDatabase) GetMembershipProof(ctx context.Context, roundID string, challenge, nodeID []byte) (*MembershipProof, error) {
+	proof, err := db.Get(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+
+	var index int
+	if len(challenge) != 0 {
+		index = slices.IndexFunc(proof.Members, func(m []byte) bool { return bytes.Equal(m, challenge) })
+	} else {
+		index = slices.IndexFunc(proof.NodeIDs, func(id []byte) bool { return bytes.Equal(id, nodeID) })
+	}
+	if index < 0 {
+		return nil, ErrMemberNotFound
+	}
+
+	tree, err := merkle.NewProvingTree(map[uint64]bool{uint64(index): true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize merkle tree: %w", err)
+	}
+	for _, member := range proof.Members {
+		if err := tree.AddLeaf(member); err != nil {
+			return nil, err
+		}
+	}
+	root, nodes := tree.RootAndProof()
+
+	return &MembershipProof{
+		Index: uint64(index),
+		Root:  root,
+		Proof: nodes,
+	}, nil
+}
+
+func NewProofsDatabase(dbPath string, proofs <-chan shared.ProofMessage, opts ...ProofsDatabaseOption) (*ProofsDatabase, error) {
 	db, err := leveldb.OpenFile(dbPath, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database @ %s: %w", dbPath, err)
 	}
 
-	return &ProofsDatabase{db, proofs}, nil
+	index, err := openProofsIndex(dbPath + "Index")
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	proofsDb := &ProofsDatabase{
+		db:            db,
+		index:         index,
+		proofs:        proofs,
+		stored:        make(chan struct{}),
+		retryInterval: DefaultRetryInterval,
+	}
+	for _, opt := range opts {
+		opt(proofsDb)
+	}
+	return proofsDb, nil
+}
+
+// Running returns whether Run is storing the proofs.
+func (db *ProofsDatabase) Running() bool {
+	return db.running.Load()
 }
 
 func (db *ProofsDatabase) Run(ctx context.Context) error {
+	db.running.Store(true)
+	defer db.running.Store(false)
 	logger := logging.FromContext(ctx).Named("proofs-db")
+	// unindexed tells whether some stored proofs failed to be indexed and are worth indexing again.
+	unindexed := db.backfillIndex(logger)
+	db.retryHooks(logger)
+	retry := time.NewTicker(db.retryInterval)
+	defer retry.Stop()
 	for {
 		select {
 		case proof := <-db.proofs:
@@ -52,21 +261,90 @@ func (db *ProofsDatabase) Run(ctx context.Context) error {
 			if err != nil {
 				return fmt.Errorf("failed serializing proof: %w", err)
 			}
-			if err := db.db.Put([]byte(proof.RoundID), serialized, &opt.WriteOptions{Sync: true}); err != nil {
+			batch := new(leveldb.Batch)
+			batch.Put([]byte(proof.RoundID), serialized)
+			if len(db.onStored) != 0 {
+				batch.Put(pendingKey(proof.RoundID), nil)
+			}
+			if err := db.db.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
 				logger.Error("failed storing proof in DB", zap.Error(err))
+				proofsDbWriteErrors.Inc()
 			} else {
+				if err := db.index.add(&proof); err != nil {
+					logger.Error("failed to index proof, retrying later", zap.String("round", proof.RoundID), zap.Error(err))
+					unindexed = true
+				}
+				db.notifyStored()
 				logger.Info("Proof saved in DB",
 					zap.String("round", proof.RoundID),
 					zap.Int("members", len(proof.Members)),
 					zap.Uint64("leaves", proof.NumLeaves))
+				db.runHooks(logger, &proof)
+			}
+		case <-retry.C:
+			db.retryHooks(logger)
+			if unindexed {
+				unindexed = db.backfillIndex(logger)
 			}
 		case <-ctx.Done():
 			logger.Info("shutting down proofs db")
+			if err := db.index.close(); err != nil {
+				db.db.Close()
+				return err
+			}
 			return db.db.Close()
 		}
 	}
 }
 
+// backfillIndex indexes the stored proofs whose round is not marked as indexed.
+// It returns whether some failed to be indexed and are worth indexing again.
+func (db *ProofsDatabase) backfillIndex(logger *zap.Logger) bool {
+	indexed, err := db.index.backfill(db.db, logger)
+	if indexed > 0 {
+		logger.Info("indexed the stored proofs", zap.Int("proofs", indexed))
+	}
+	if err != nil {
+		logger.Error("failed to index the stored proofs, retrying later", zap.Error(err))
+		return true
+	}
+	return false
+}
+
+// runHooks calls the onStored hooks with the proof, and clears its pending marker if they all succeed.
+func (db *ProofsDatabase) runHooks(logger *zap.Logger, proof *shared.ProofMessage) {
+	for _, onStored := range db.onStored {
+		if err := onStored(proof); err != nil {
+			logger.Error("failed to handle stored proof, retrying later", zap.String("round", proof.RoundID), zap.Error(err))
+			return
+		}
+	}
+	if err := db.db.Delete(pendingKey(proof.RoundID), &opt.WriteOptions{Sync: true}); err != nil {
+		logger.Warn("failed to clear the pending marker of proof", zap.String("round", proof.RoundID), zap.Error(err))
+	}
+}
+
+// retryHooks calls the onStored hooks again with the proofs that have a pending marker.
+func (db *ProofsDatabase) retryHooks(logger *zap.Logger) {
+	iter := db.db.NewIterator(util.BytesPrefix(pendingPrefix), nil)
+	defer iter.Release()
+	for iter.Next() {
+		roundID := string(iter.Key()[len(pendingPrefix):])
+		data, err := db.db.Get([]byte(roundID), nil)
+		if err == nil {
+			var proof *shared.ProofMessage
+			if proof, err = deserializeProofMsg(data); err == nil {
+				db.runHooks(logger, proof)
+				continue
+			}
+		}
+		logger.Error("failed to read the proof to handle", zap.String("round", roundID), zap.Error(err))
+	}
+	if err := iter.Error(); err != nil {
+		logger.Error("failed to read the pending proofs", zap.Error(err))
+	}
+}
+
 func serializeProofMsg(proof shared.ProofMessage) ([]byte, error) {
 	var dataBuf bytes.Buffer
 	if _, err := proof.EncodeScale(scale.NewEncoder(&dataBuf)); err != nil {
@@ -75,3 +353,120 @@ func serializeProofMsg(proof shared.ProofMessage) ([]byte, error) {
 
 	return dataBuf.Bytes(), nil
 }
+
+// deserializeProofMsg decodes a proof message of any version.
+// Versioned messages start with their version, while messages of version 0 start
+// with the length of the Merkle root (32), which decodes as an unknown version.
+func deserializeProofMsg(data []byte) (*shared.ProofMessage, error) {
+	version, _, err := scale.DecodeCompact8(scale.NewDecoder(bytes.NewReader(data)))
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 && version <= shared.ProofMessageVersion {
+		proof := &shared.ProofMessage{}
+		if _, err := proof.DecodeScale(scale.NewDecoder(bytes.NewReader(data))); err != nil {
+			return nil, err
+		}
+		return proof, nil
+	}
+	return deserializeLegacyProofMsg(data)
+}
+
+// deserializeLegacyProofMsg decodes a proof message of version 0,
+// which only holds the proof, the service key and the round ID.
+func deserializeLegacyProofMsg(data []byte) (*shared.ProofMessage, error) {
+	proof := &shared.ProofMessage{}
+	dec := scale.NewDecoder(bytes.NewReader(data))
+	if _, err := proof.Proof.DecodeScale(dec); err != nil {
+		return nil, err
+	}
+	var err error
+	if proof.ServicePubKey, _, err = scale.DecodeByteSlice(dec); err != nil {
+		return nil, err
+	}
+	if proof.RoundID, _, err = scale.DecodeString(dec); err != nil {
+		return nil, err
+	}
+	return proof, nil
+}
+
+// decodeProofSummary decodes the summary of a proof message of any version,
+// skipping over the proof rather than decoding it.
+func decodeProofSummary(data []byte) (*ProofSummary, error) {
+	r := bytes.NewReader(data)
+	dec := scale.NewDecoder(r)
+	summary := &ProofSummary{}
+	version, _, err := scale.DecodeCompact8(dec)
+	if err != nil {
+		return nil, err
+	}
+	if version != 0 && version <= shared.ProofMessageVersion {
+		summary.Version = version
+	} else {
+		// A message of version 0, which starts with the proof.
+		r.Reset(data)
+	}
+
+	// The proof: its Merkle proof, members and number of leaves.
+	if err := skipByteSlice(dec, r); err != nil {
+		return nil, err
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := skipSliceOfByteSlice(dec, r); err != nil {
+			return nil, err
+		}
+	}
+	if summary.Members, err = skipSliceOfByteSlice(dec, r); err != nil {
+		return nil, err
+	}
+	if summary.NumLeaves, _, err = scale.DecodeCompact64(dec); err != nil {
+		return nil, err
+	}
+	if err := skipByteSlice(dec, r); err != nil {
+		return nil, err
+	}
+	if summary.RoundID, _, err = scale.DecodeString(dec); err != nil {
+		return nil, err
+	}
+	if summary.Version == 0 {
+		return summary, nil
+	}
+
+	if summary.Epoch, _, err = scale.DecodeCompact32(dec); err != nil {
+		return nil, err
+	}
+	if summary.RoundStart, _, err = scale.DecodeCompact64(dec); err != nil {
+		return nil, err
+	}
+	if summary.RoundEnd, _, err = scale.DecodeCompact64(dec); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// skipByteSlice skips over a byte slice read by `dec` from `r`.
+func skipByteSlice(dec *scale.Decoder, r *bytes.Reader) error {
+	length, _, err := scale.DecodeLen(dec, scale.MaxElements)
+	if err != nil {
+		return err
+	}
+	if int64(length) > int64(r.Len()) {
+		return io.ErrUnexpectedEOF
+	}
+	_, err = r.Seek(int64(length), io.SeekCurrent)
+	return err
+}
+
+// skipSliceOfByteSlice skips over a slice of byte slices read by `dec` from `r` and returns its length.
+func skipSliceOfByteSlice(dec *scale.Decoder, r *bytes.Reader) (int, error) {
+	length, _, err := scale.DecodeLen(dec, scale.MaxElements)
+	if err != nil {
+		return 0, err
+	}
+	for i := uint32(0); i < length; i++ {
+		if err := skipByteSlice(dec, r); err != nil {
+			return 0, err
+		}
+	}
+	return int(length), nil
+}
@@ -0,0 +1,322 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spacemeshos/go-scale"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/spacemeshos/poet/hash"
+	"github.com/spacemeshos/poet/shared"
+)
+
+func TestSerializeProofMsg(t *testing.T) {
+	req := require.New(t)
+	proof := shared.ProofMessage{
+		Version: shared.ProofMessageVersion,
+		Proof: shared.Proof{
+			MerkleProof: shared.MerkleProof{Root: bytes.Repeat([]byte{1}, 32)},
+			Members:     [][]byte{[]byte("member")},
+			NumLeaves:   77,
+		},
+		ServicePubKey:   []byte("pubkey"),
+		RoundID:         "7",
+		Epoch:           7,
+		RoundStart:      1000,
+		RoundEnd:        2000,
+		Statement:       []byte("statement"),
+		SecurityParam:   15,
+		LabelDifficulty: 10,
+		HashSuite:       hash.SHA3,
+		NodeIDs:         [][]byte{[]byte("node")},
+		Signature:       []byte("signature"),
+	}
+	data, err := serializeProofMsg(proof)
+	req.NoError(err)
+	decoded, err := deserializeProofMsg(data)
+	req.NoError(err)
+	req.Equal(&proof, decoded)
+
+	summary, err := decodeProofSummary(data)
+	req.NoError(err)
+	req.Equal(&ProofSummary{
+		Version:    shared.ProofMessageVersion,
+		RoundID:    "7",
+		Members:    1,
+		NumLeaves:  77,
+		Epoch:      7,
+		RoundStart: 1000,
+		RoundEnd:   2000,
+	}, summary)
+
+	_, err = decodeProofSummary(data[:len(data)/2])
+	req.Error(err)
+}
+
+func TestDeserializeProofMsg_Legacy(t *testing.T) {
+	legacy := shared.ProofMessage{
+		Proof: shared.Proof{
+			MerkleProof: shared.MerkleProof{Root: bytes.Repeat([]byte{1}, 32)},
+			Members:     [][]byte{[]byte("member")},
+			NumLeaves:   77,
+		},
+		ServicePubKey: []byte("pubkey"),
+		RoundID:       "7",
+	}
+
+	// Messages of version 0 hold the proof, the service key and the round ID.
+	var buf bytes.Buffer
+	enc := scale.NewEncoder(&buf)
+	_, err := legacy.Proof.EncodeScale(enc)
+	require.NoError(t, err)
+	_, err = scale.EncodeByteSlice(enc, legacy.ServicePubKey)
+	require.NoError(t, err)
+	_, err = scale.EncodeString(enc, legacy.RoundID)
+	require.NoError(t, err)
+
+	decoded, err := deserializeProofMsg(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, &legacy, decoded)
+
+	summary, err := decodeProofSummary(buf.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, &ProofSummary{RoundID: "7", Members: 1, NumLeaves: 77}, summary)
+}
+
+func TestProofsDatabase_List(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	db, err := NewProofsDatabase(filepath.Join(t.TempDir(), "proofs"), nil)
+	req.NoError(err)
+	t.Cleanup(func() {
+		req.NoError(db.index.close())
+		req.NoError(db.db.Close())
+	})
+
+	for _, proof := range []shared.ProofMessage{testProofMessage("1", 10), testProofMessage("3", 30)} {
+		serialized, err := serializeProofMsg(proof)
+		req.NoError(err)
+		req.NoError(db.db.Put([]byte(proof.RoundID), serialized, nil))
+	}
+	req.NoError(db.db.Put([]byte("2"), []byte("garbage"), nil))
+	req.NoError(db.db.Put(pendingKey("3"), nil, nil))
+
+	// The proof that fails to decode is skipped.
+	summaries, err := db.List(context.Background())
+	req.NoError(err)
+	req.Len(summaries, 2)
+	req.Equal("1", summaries[0].RoundID)
+	req.EqualValues(10, summaries[0].NumLeaves)
+	req.Equal("3", summaries[1].RoundID)
+	req.EqualValues(30, summaries[1].NumLeaves)
+
+	_, err = db.Summary(context.Background(), "2")
+	req.Error(err)
+	_, err = db.Summary(context.Background(), "4")
+	req.ErrorIs(err, ErrNotFound)
+}
+
+func testProofMessage(roundID string, leaves uint64) shared.ProofMessage {
+	return shared.ProofMessage{
+		Version: shared.ProofMessageVersion,
+		Proof: shared.Proof{
+			MerkleProof: shared.MerkleProof{Root: bytes.Repeat([]byte{1}, 32)},
+			NumLeaves:   leaves,
+		},
+		RoundID:   roundID,
+		HashSuite: hash.SHA256,
+	}
+}
+
+func TestProofsDatabase_Wait(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	proofs := make(chan shared.ProofMessage)
+	db, err := NewProofsDatabase(filepath.Join(t.TempDir(), "proofs"), proofs)
+	req.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var eg errgroup.Group
+	eg.Go(func() error { return db.Run(ctx) })
+	t.Cleanup(func() {
+		cancel()
+		req.NoError(eg.Wait())
+	})
+
+	timeout, cancelTimeout := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelTimeout()
+	_, err = db.Wait(timeout, "1")
+	req.ErrorIs(err, context.DeadlineExceeded)
+
+	waited := make(chan *shared.ProofMessage, 1)
+	eg.Go(func() error {
+		proof, err := db.Wait(context.Background(), "1")
+		waited <- proof
+		return err
+	})
+	proofs <- testProofMessage("0", 1)
+	proofs <- testProofMessage("1", 2)
+	req.EqualValues(2, (<-waited).NumLeaves)
+
+	// A stored proof is returned right away.
+	proof, err := db.Wait(context.Background(), "0")
+	req.NoError(err)
+	req.EqualValues(1, proof.NumLeaves)
+}
+
+func TestProofsDatabase_Find(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	dir := t.TempDir()
+	proofs := make(chan shared.ProofMessage)
+
+	run := func() (db *ProofsDatabase, stop func()) {
+		db, err := NewProofsDatabase(filepath.Join(dir, "proofs"), proofs)
+		req.NoError(err)
+		ctx, cancel := context.WithCancel(context.Background())
+		var eg errgroup.Group
+		eg.Go(func() error { return db.Run(ctx) })
+		return db, func() {
+			cancel()
+			req.NoError(eg.Wait())
+		}
+	}
+	db, stop := run()
+
+	for _, roundID := range []string{"1", "2"} {
+		proof := testProofMessage(roundID, 10)
+		proof.Members = [][]byte{[]byte("other-" + roundID), []byte("challenge-" + roundID)}
+		proof.NodeIDs = [][]byte{[]byte("other-node-" + roundID), []byte("node")}
+		proofs <- proof
+		_, err := db.Wait(context.Background(), roundID)
+		req.NoError(err)
+	}
+
+	check := func(db *ProofsDatabase) {
+		locations, err := db.Find(context.Background(), []byte("challenge-2"), nil)
+		req.NoError(err)
+		req.Equal([]MemberLocation{{RoundID: "2", Index: 1}}, locations)
+
+		locations, err = db.Find(context.Background(), nil, []byte("node"))
+		req.NoError(err)
+		req.Equal([]MemberLocation{{RoundID: "1", Index: 1}, {RoundID: "2", Index: 1}}, locations)
+
+		locations, err = db.Find(context.Background(), nil, []byte("unknown"))
+		req.NoError(err)
+		req.Empty(locations)
+	}
+	check(db)
+	stop()
+
+	// The proofs stored before the index existed are indexed on start,
+	// skipping the ones that cannot be deserialized.
+	req.NoError(os.RemoveAll(filepath.Join(dir, "proofsIndex")))
+	corrupted, err := leveldb.OpenFile(filepath.Join(dir, "proofs"), nil)
+	req.NoError(err)
+	req.NoError(corrupted.Put([]byte("0"), []byte("corrupted"), nil))
+	req.NoError(corrupted.Close())
+	db, stop = run()
+	defer stop()
+	// Storing a proof waits for the stored ones to be indexed.
+	proofs <- testProofMessage("3", 10)
+	check(db)
+}
+
+func TestProofsDatabase_RetriesIndexing(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	db, err := NewProofsDatabase(filepath.Join(t.TempDir(), "proofs"), nil)
+	req.NoError(err)
+	t.Cleanup(func() { db.db.Close() })
+	proof := testProofMessage("1", 10)
+	proof.Members = [][]byte{[]byte("challenge")}
+	serialized, err := serializeProofMsg(proof)
+	req.NoError(err)
+	req.NoError(db.db.Put([]byte("1"), serialized, nil))
+
+	// The round is not marked as indexed while the index fails.
+	path := filepath.Join(t.TempDir(), "index")
+	req.NoError(db.index.close())
+	req.True(db.backfillIndex(zap.NewNop()))
+
+	db.index, err = openProofsIndex(path)
+	req.NoError(err)
+	t.Cleanup(func() { db.index.close() })
+	req.False(db.backfillIndex(zap.NewNop()))
+	locations, err := db.Find(context.Background(), []byte("challenge"), nil)
+	req.NoError(err)
+	req.Equal([]MemberLocation{{RoundID: "1", Index: 0}}, locations)
+}
+
+func TestProofsDatabase_OnStored(t *testing.T) {
+	t.Parallel()
+	req := require.New(t)
+	dir := t.TempDir()
+	proofs := make(chan shared.ProofMessage)
+
+	var mu sync.Mutex
+	var handled []string
+	failures := 1
+	onStored := func(proof *shared.ProofMessage) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if failures > 0 {
+			failures--
+			return errors.New("failed")
+		}
+		handled = append(handled, proof.RoundID)
+		return nil
+	}
+	handledRounds := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), handled...)
+	}
+	run := func() (db *ProofsDatabase, stop func()) {
+		db, err := NewProofsDatabase(filepath.Join(dir, "proofs"), proofs, WithOnStored(onStored), WithRetryInterval(time.Millisecond))
+		req.NoError(err)
+		ctx, cancel := context.WithCancel(context.Background())
+		var eg errgroup.Group
+		eg.Go(func() error { return db.Run(ctx) })
+		return db, func() {
+			cancel()
+			req.NoError(eg.Wait())
+		}
+	}
+
+	// A failed hook is called again until it succeeds.
+	db, stop := run()
+	proofs <- testProofMessage("1", 10)
+	req.Eventually(func() bool { return len(handledRounds()) == 1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	req.Equal([]string{"1"}, handledRounds())
+	stored, err := db.List(context.Background())
+	req.NoError(err)
+	req.Len(stored, 1)
+	stop()
+
+	// A proof stored without its hooks being called, e.g. before a crash, is handled on start.
+	db, err = NewProofsDatabase(filepath.Join(dir, "proofs"), proofs)
+	req.NoError(err)
+	serialized, err := serializeProofMsg(testProofMessage("2", 10))
+	req.NoError(err)
+	req.NoError(db.db.Put([]byte("2"), serialized, nil))
+	req.NoError(db.db.Put(pendingKey("2"), nil, nil))
+	req.NoError(db.index.close())
+	req.NoError(db.db.Close())
+
+	_, stop = run()
+	defer stop()
+	req.Eventually(func() bool { return len(handledRounds()) == 2 }, time.Second, time.Millisecond)
+	req.Equal([]string{"1", "2"}, handledRounds())
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spacemeshos/poet/prover"
+)
+
+// EventType is the kind of a round lifecycle event.
+type EventType int
+
+const (
+	// RoundOpened is published when a new round opens for registrations.
+	RoundOpened EventType = iota + 1
+	// ExecutionStarted is published when a round closes and starts generating its proof.
+	ExecutionStarted
+	// CheckpointPersisted is published when an executing round persists its progress.
+	CheckpointPersisted
+	// ProofReady is published when a round finished generating its proof.
+	ProofReady
+	// RoundFailed is published when a round execution fails.
+	RoundFailed
+	// ProgressReported is published periodically while a round generates its proof.
+	ProgressReported
+)
+
+func (t EventType) String() string {
+	switch t {
+	case RoundOpened:
+		return "round-opened"
+	case ExecutionStarted:
+		return "execution-started"
+	case CheckpointPersisted:
+		return "checkpoint-persisted"
+	case ProofReady:
+		return "proof-ready"
+	case RoundFailed:
+		return "round-failed"
+	case ProgressReported:
+		return "progress-reported"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is a round lifecycle event published by the Service loop.
+type Event struct {
+	Type    EventType
+	RoundID string
+	Time    time.Time
+	// NumLeaves is the number of leaves of the proving tree.
+	// It is set for CheckpointPersisted, ProgressReported and ProofReady events.
+	NumLeaves uint64
+	// Err is the reason of the failure of RoundFailed events.
+	Err error
+	// Progress is the progress of the proof generation of ProgressReported events.
+	Progress *prover.Progress
+}
+
+// eventsBufferSize is the number of events a subscriber can lag behind
+// before it is dropped.
+const eventsBufferSize = 64
+
+var ErrStopped = errors.New("service stopped")
+
+type subscriber struct {
+	ctx    context.Context
+	events chan Event
+}
+
+// Subscribe returns a channel receiving the round lifecycle events published from now on.
+// The channel is closed when `ctx` is canceled, when the Service stops
+// or when the subscriber doesn't keep up with the events.
+func (s *Service) Subscribe(ctx context.Context) (<-chan Event, error) {
+	resp := make(chan (<-chan Event), 1)
+	cmd := func(s *Service) {
+		sub := &subscriber{ctx: ctx, events: make(chan Event, eventsBufferSize)}
+		s.subscribers = append(s.subscribers, sub)
+		resp <- sub.events
+	}
+
+	select {
+	case s.commands <- cmd:
+	case <-s.stopped:
+		return nil, ErrStopped
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case events := <-resp:
+		return events, nil
+	case <-s.stopped:
+		return nil, ErrStopped
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// publish sends the event to the subscribers.
+// Subscribers that went away or lag behind are dropped.
+// It must be called from the Service loop.
+func (s *Service) publish(ev Event) {
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	active := s.subscribers[:0]
+	for _, sub := range s.subscribers {
+		if sub.ctx.Err() != nil {
+			close(sub.events)
+			continue
+		}
+		select {
+		case sub.events <- ev:
+			active = append(active, sub)
+		default:
+			close(sub.events)
+		}
+	}
+	s.subscribers = active
+}
+
+// closeSubscribers closes the channels of all subscribers.
+// It must be called from the Service loop.
+func (s *Service) closeSubscribers() {
+	for _, sub := range s.subscribers {
+		close(sub.events)
+	}
+	s.subscribers = nil
+}
@@ -0,0 +1,58 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/spacemeshos/poet/gateway/challenge_verifier"
+)
+
+var (
+	submissions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "poet",
+		Subsystem: "service",
+		Name:      "submissions_total",
+		Help: "Number of challenge submissions, by round and result. " +
+			"The round is empty for the challenges rejected before reaching the open round.",
+	}, []string{"round", "result"})
+	roundLeaves = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "poet",
+		Subsystem: "round",
+		Name:      "leaves",
+		Help:      "Number of leaves of the proving tree of an executing round.",
+	}, []string{"round"})
+	roundLeavesPerSecond = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "poet",
+		Subsystem: "round",
+		Name:      "leaves_per_second",
+		Help:      "Rate in which leaves are added to the proving tree of an executing round.",
+	}, []string{"round"})
+	proofsDbWriteErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: "poet",
+		Subsystem: "proofs_db",
+		Name:      "write_errors_total",
+		Help:      "Number of proofs that failed to be stored in the proofs database.",
+	})
+)
+
+// submissionResult labels the result of a submission in the metrics.
+func submissionResult(err error) string {
+	switch {
+	case err == nil:
+		return "submitted"
+	case errors.Is(err, ErrNotStarted):
+		return "not_started"
+	case errors.Is(err, ErrChallengeAlreadySubmitted):
+		return "already_submitted"
+	case errors.Is(err, challenge_verifier.ErrChallengeInvalid):
+		return "invalid"
+	case errors.Is(err, challenge_verifier.ErrDisagreement):
+		return "disagreement"
+	case errors.Is(err, challenge_verifier.ErrCouldNotVerify):
+		return "could_not_verify"
+	default:
+		return "error"
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	xdr "github.com/nullstyle/go-xdr/xdr3"
+
+	"github.com/spacemeshos/poet/hash"
+	"github.com/spacemeshos/poet/shared"
+)
+
+// The layouts of the round state files of each version. They are frozen:
+// changing the layout of roundState requires a new version and a migration to it.
+type (
+	// roundStateV0 is the layout of the round states written before the state files had a header.
+	roundStateV0 struct {
+		Opened           time.Time
+		ExecutionStarted time.Time
+		Execution        *executionStateV0
+	}
+
+	executionStateV0 struct {
+		Epoch         uint32
+		SecurityParam uint8
+		Members       [][]byte
+		Statement     []byte
+		ParkedNodes   [][]byte
+		NumLeaves     uint64
+		NIP           *shared.MerkleProof
+	}
+
+	roundStateV1 struct {
+		Opened           time.Time
+		ExecutionStarted time.Time
+		Execution        *executionStateV1
+	}
+
+	executionStateV1 struct {
+		Epoch           uint32
+		SecurityParam   uint8
+		Members         [][]byte
+		Statement       []byte
+		ParkedNodes     [][]byte
+		NumLeaves       uint64
+		NIP             *shared.MerkleProof
+		HashSuite       string
+		LabelDifficulty uint32
+		FixedLeaves     uint64
+	}
+)
+
+// migrateRoundStateV0 upgrades round state files to record the hash suite, the label difficulty
+// and the fixed leaf count of the round. Rounds opened before they were recorded use SHA-256
+// and LabelHashNestingDepth, and execute until the round end.
+func migrateRoundStateV0(payload []byte, v any) ([]byte, error) {
+	if _, ok := v.(*roundState); !ok {
+		return payload, nil
+	}
+	var old roundStateV0
+	if err := unmarshalPayload(payload, &old); err != nil {
+		return nil, err
+	}
+	state := roundStateV1{Opened: old.Opened, ExecutionStarted: old.ExecutionStarted}
+	if e := old.Execution; e != nil {
+		state.Execution = &executionStateV1{
+			Epoch:           e.Epoch,
+			SecurityParam:   e.SecurityParam,
+			Members:         e.Members,
+			Statement:       e.Statement,
+			ParkedNodes:     e.ParkedNodes,
+			NumLeaves:       e.NumLeaves,
+			NIP:             e.NIP,
+			HashSuite:       hash.SHA256,
+			LabelDifficulty: hash.LabelHashNestingDepth,
+		}
+	}
+	return marshalPayload(&state)
+}
+
+func unmarshalPayload(payload []byte, v any) error {
+	if _, err := xdr.Unmarshal(bytes.NewReader(payload), v); err != nil {
+		return fmt.Errorf("%w: failed to deserialize: %v", ErrFileIsCorrupted, err)
+	}
+	return nil
+}
+
+func marshalPayload(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := xdr.Marshal(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
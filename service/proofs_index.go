@@ -0,0 +1,127 @@
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"go.uber.org/zap"
+
+	"github.com/spacemeshos/poet/shared"
+)
+
+// MemberLocation locates a member in the proof of a round.
+type MemberLocation struct {
+	RoundID string
+	Index   uint64
+}
+
+// proofsIndex maps the challenges and the node IDs of the members of the stored proofs to their location.
+// A member is indexed under the keys:
+// - "challenge/" || hex(challenge) || "/" || round ID,
+// - "node/" || hex(node ID) || "/" || round ID,
+// holding its big-endian index. The indexed rounds are marked under "round/" || round ID.
+type proofsIndex struct {
+	db *leveldb.DB
+}
+
+func openProofsIndex(path string) (*proofsIndex, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database @ %s: %w", path, err)
+	}
+	return &proofsIndex{db: db}, nil
+}
+
+func challengeIndexPrefix(challenge []byte) []byte {
+	return []byte("challenge/" + hex.EncodeToString(challenge) + "/")
+}
+
+func nodeIndexPrefix(nodeID []byte) []byte {
+	return []byte("node/" + hex.EncodeToString(nodeID) + "/")
+}
+
+func roundIndexKey(roundID string) []byte {
+	return []byte("round/" + roundID)
+}
+
+// add indexes the members of the proof.
+func (idx *proofsIndex) add(proof *shared.ProofMessage) error {
+	batch := new(leveldb.Batch)
+	for i, member := range proof.Members {
+		index := binary.BigEndian.AppendUint64(nil, uint64(i))
+		batch.Put(append(challengeIndexPrefix(member), proof.RoundID...), index)
+		if i < len(proof.NodeIDs) {
+			batch.Put(append(nodeIndexPrefix(proof.NodeIDs[i]), proof.RoundID...), index)
+		}
+	}
+	batch.Put(roundIndexKey(proof.RoundID), nil)
+	return idx.db.Write(batch, &opt.WriteOptions{Sync: true})
+}
+
+// backfill indexes the proofs whose round is not marked as indexed, i.e. stored before the index existed
+// or whose indexing failed. The proofs that cannot be deserialized are logged and skipped, as indexing
+// them again would fail again. It returns the number of proofs indexed, and an error if some failed
+// to be indexed.
+func (idx *proofsIndex) backfill(proofs *leveldb.DB, logger *zap.Logger) (int, error) {
+	iter := proofs.NewIterator(nil, nil)
+	defer iter.Release()
+
+	indexed := 0
+	var result *multierror.Error
+	for iter.Next() {
+		if bytes.HasPrefix(iter.Key(), pendingPrefix) {
+			continue
+		}
+		roundID := string(iter.Key())
+		if has, err := idx.db.Has(roundIndexKey(roundID), nil); err != nil {
+			result = multierror.Append(result, err)
+			continue
+		} else if has {
+			continue
+		}
+		proof, err := deserializeProofMsg(iter.Value())
+		if err != nil {
+			logger.Error("skipping proof that cannot be deserialized", zap.String("round", roundID), zap.Error(err))
+			continue
+		}
+		if err := idx.add(proof); err != nil {
+			result = multierror.Append(result, fmt.Errorf("failed to index proof for %s: %w", roundID, err))
+			continue
+		}
+		indexed++
+	}
+	if err := iter.Error(); err != nil {
+		result = multierror.Append(result, err)
+	}
+	return indexed, result.ErrorOrNil()
+}
+
+// find returns the locations of the member in the proofs, by its challenge if one is given,
+// or by the ID of the node that registered it otherwise.
+func (idx *proofsIndex) find(challenge, nodeID []byte) ([]MemberLocation, error) {
+	prefix := nodeIndexPrefix(nodeID)
+	if len(challenge) != 0 {
+		prefix = challengeIndexPrefix(challenge)
+	}
+	iter := idx.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	var locations []MemberLocation
+	for iter.Next() {
+		locations = append(locations, MemberLocation{
+			RoundID: string(iter.Key()[len(prefix):]),
+			Index:   binary.BigEndian.Uint64(iter.Value()),
+		})
+	}
+	return locations, iter.Error()
+}
+
+func (idx *proofsIndex) close() error {
+	return idx.db.Close()
+}
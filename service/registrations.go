@@ -0,0 +1,136 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	xdr "github.com/nullstyle/go-xdr/xdr3"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// Registration is a challenge registered in a round, with the provenance of its verification.
+type Registration struct {
+	NodeID    []byte
+	Hash      []byte
+	Challenge []byte
+	Signature []byte
+	// Verifiers names the verifiers that verified the challenge, e.g. their gateways.
+	Verifiers   []string
+	VerifiedAt  time.Time
+	SubmittedAt time.Time
+}
+
+// registrationRecord is a Registration as stored in the registrations DB.
+type registrationRecord struct {
+	NodeID      []byte
+	Hash        []byte
+	Challenge   []byte
+	Signature   []byte
+	Verifiers   []string
+	VerifiedAt  int64
+	SubmittedAt int64
+}
+
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func fromUnixNano(nsec int64) time.Time {
+	if nsec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nsec)
+}
+
+// registrationsDb keeps the registrations of all rounds, including the ones torn down after execution.
+// A registration is stored under the key: round ID || "/" || node ID.
+type registrationsDb struct {
+	db *leveldb.DB
+}
+
+func openRegistrationsDb(path string) (*registrationsDb, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database @ %s: %w", path, err)
+	}
+	return &registrationsDb{db: db}, nil
+}
+
+func registrationsPrefix(roundID string) []byte {
+	return []byte(roundID + "/")
+}
+
+func (db *registrationsDb) add(roundID string, registration *Registration) error {
+	record := registrationRecord{
+		NodeID:      registration.NodeID,
+		Hash:        registration.Hash,
+		Challenge:   registration.Challenge,
+		Signature:   registration.Signature,
+		Verifiers:   registration.Verifiers,
+		VerifiedAt:  unixNano(registration.VerifiedAt),
+		SubmittedAt: unixNano(registration.SubmittedAt),
+	}
+	var data bytes.Buffer
+	if _, err := xdr.Marshal(&data, &record); err != nil {
+		return fmt.Errorf("serialization failure: %w", err)
+	}
+	key := append(registrationsPrefix(roundID), registration.NodeID...)
+	return db.db.Put(key, data.Bytes(), &opt.WriteOptions{Sync: true})
+}
+
+// list returns the registrations of the round.
+func (db *registrationsDb) list(roundID string) ([]*Registration, error) {
+	iter := db.db.NewIterator(util.BytesPrefix(registrationsPrefix(roundID)), nil)
+	defer iter.Release()
+
+	var registrations []*Registration
+	for iter.Next() {
+		var record registrationRecord
+		if _, err := xdr.Unmarshal(bytes.NewReader(iter.Value()), &record); err != nil {
+			return nil, fmt.Errorf("failed to deserialize registration %x: %w", iter.Key(), err)
+		}
+		registrations = append(registrations, &Registration{
+			NodeID:      record.NodeID,
+			Hash:        record.Hash,
+			Challenge:   record.Challenge,
+			Signature:   record.Signature,
+			Verifiers:   record.Verifiers,
+			VerifiedAt:  fromUnixNano(record.VerifiedAt),
+			SubmittedAt: fromUnixNano(record.SubmittedAt),
+		})
+	}
+	return registrations, iter.Error()
+}
+
+// prune deletes the registrations of the rounds of epochs lower than `epoch`.
+func (db *registrationsDb) prune(epoch uint32) (int, error) {
+	iter := db.db.NewIterator(nil, nil)
+	defer iter.Release()
+
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		roundID, _, found := strings.Cut(string(iter.Key()), "/")
+		if !found {
+			continue
+		}
+		if roundEpoch, err := strconv.ParseUint(roundID, 10, 32); err == nil && roundEpoch < uint64(epoch) {
+			batch.Delete(iter.Key())
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+	return batch.Len(), db.db.Write(batch, nil)
+}
+
+func (db *registrationsDb) close() error {
+	return db.db.Close()
+}
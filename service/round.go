@@ -29,6 +29,13 @@ type executionState struct {
 	ParkedNodes   [][]byte
 	NumLeaves     uint64
 	NIP           *shared.MerkleProof
+	// HashSuite is the name of the hash suite the proof is generated with.
+	HashSuite string
+	// LabelDifficulty is the number of recursive hashes per label.
+	LabelDifficulty uint32
+	// FixedLeaves is the number of leaves the round executes until,
+	// or zero if it executes until the round end.
+	FixedLeaves uint64
 }
 
 const roundStateFileBaseName = "state.bin"
@@ -52,7 +59,9 @@ type round struct {
 	ID      string
 
 	challengesDb *leveldb.DB
-	execution    *executionState
+	// members is the number of challenges in the challenges DB.
+	members   int
+	execution *executionState
 
 	opened           time.Time
 	executionStarted time.Time
@@ -62,6 +71,13 @@ type round struct {
 	executionEndedChan   chan struct{}
 
 	stateCache *roundState
+
+	// nodeIDs are the IDs of the nodes that registered the round members, in the same order.
+	// They are collected once the execution ends, as the challenges DB doesn't outlive the round.
+	nodeIDs [][]byte
+
+	// events, if set, receives the events published by the round while it executes.
+	events chan<- Event
 }
 
 func (r *round) Epoch() uint32 {
@@ -81,10 +97,16 @@ func newRound(datadir string, epoch uint32) (*round, error) {
 		return nil, err
 	}
 	r.challengesDb = db
+	r.members, err = countChallenges(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
 
 	r.execution = new(executionState)
 	r.execution.Epoch = epoch
 	r.execution.SecurityParam = shared.T
+	r.execution.LabelDifficulty = hash.LabelHashNestingDepth
 
 	return r, nil
 }
@@ -118,11 +140,37 @@ func (r *round) submit(key, challenge []byte) error {
 	} else if has {
 		return fmt.Errorf("%w: key: %X", ErrChallengeAlreadySubmitted, key)
 	}
-	return r.challengesDb.Put(key, challenge, &opt.WriteOptions{Sync: true})
+	if err := r.challengesDb.Put(key, challenge, &opt.WriteOptions{Sync: true}); err != nil {
+		return err
+	}
+	r.members++
+	return nil
+}
+
+// withdraw removes the challenge submitted by the node.
+func (r *round) withdraw(key []byte) error {
+	if has, err := r.challengesDb.Has(key, nil); err != nil || !has {
+		return err
+	}
+	if err := r.challengesDb.Delete(key, &opt.WriteOptions{Sync: true}); err != nil {
+		return err
+	}
+	r.members--
+	return nil
+}
+
+// challenge returns the challenge registered by the node.
+func (r *round) challenge(nodeID []byte) ([]byte, error) {
+	return r.challengesDb.Get(nodeID, nil)
 }
 
+// numChallenges returns the number of challenges submitted to the round, without reading the challenges DB.
 func (r *round) numChallenges() int {
-	iter := r.challengesDb.NewIterator(nil, nil)
+	return r.members
+}
+
+func countChallenges(db *leveldb.DB) (int, error) {
+	iter := db.NewIterator(nil, nil)
 	defer iter.Release()
 
 	var num int
@@ -130,7 +178,21 @@ func (r *round) numChallenges() int {
 		num++
 	}
 
-	return num
+	return num, iter.Error()
+}
+
+func (r *round) registeredNodeIDs() ([][]byte, error) {
+	iter := r.challengesDb.NewIterator(nil, nil)
+	defer iter.Release()
+
+	nodeIDs := make([][]byte, 0)
+	for iter.Next() {
+		nodeID := make([]byte, len(iter.Key()))
+		copy(nodeID, iter.Key())
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+
+	return nodeIDs, iter.Error()
 }
 
 func (r *round) isEmpty() bool {
@@ -139,9 +201,13 @@ func (r *round) isEmpty() bool {
 	return !iter.Next()
 }
 
-func (r *round) execute(ctx context.Context, end time.Time, minMemoryLayer uint) error {
+func (r *round) execute(ctx context.Context, limit prover.Limit, minMemoryLayer uint) error {
 	logger := logging.FromContext(ctx).With(zap.String("round", r.ID))
-	logger.Sugar().Infof("executing until %v...", end)
+	if limit.Leaves != 0 {
+		logger.Sugar().Infof("executing until %d leaves...", limit.Leaves)
+	} else {
+		logger.Sugar().Infof("executing until %v...", limit.Deadline)
+	}
 
 	r.executionStarted = time.Now()
 	if err := r.saveState(); err != nil {
@@ -150,7 +216,11 @@ func (r *round) execute(ctx context.Context, end time.Time, minMemoryLayer uint)
 
 	close(r.executionStartedChan)
 
-	var err error
+	suite, err := hash.SuiteByName(r.execution.HashSuite)
+	if err != nil {
+		return err
+	}
+
 	r.execution.Members, r.execution.Statement, err = r.calcMembersAndStatement()
 	if err != nil {
 		return err
@@ -163,12 +233,14 @@ func (r *round) execute(ctx context.Context, end time.Time, minMemoryLayer uint)
 	r.execution.NumLeaves, r.execution.NIP, err = prover.GenerateProof(
 		ctx,
 		r.datadir,
-		hash.GenLabelHashFunc(r.execution.Statement),
-		hash.GenMerkleHashFunc(r.execution.Statement),
-		end,
+		suite.LabelHash(r.execution.Statement, r.execution.LabelDifficulty),
+		suite.MerkleHash(r.execution.Statement),
+		suite.FiatShamir,
+		limit,
 		r.execution.SecurityParam,
 		minMemoryLayer,
 		r.persistExecution,
+		r.reportProgress,
 	)
 	if err != nil {
 		return err
@@ -176,6 +248,9 @@ func (r *round) execute(ctx context.Context, end time.Time, minMemoryLayer uint)
 	if err := r.saveState(); err != nil {
 		return err
 	}
+	if r.nodeIDs, err = r.registeredNodeIDs(); err != nil {
+		return err
+	}
 
 	close(r.executionEndedChan)
 
@@ -197,18 +272,43 @@ func (r *round) persistExecution(ctx context.Context, tree *merkle.Tree, treeCac
 		return err
 	}
 
+	if r.events != nil {
+		select {
+		case r.events <- Event{Type: CheckpointPersisted, RoundID: r.ID, Time: time.Now(), NumLeaves: numLeaves}:
+		case <-ctx.Done():
+		}
+	}
+
 	return nil
 }
 
-func (r *round) recoverExecution(ctx context.Context, state *executionState, end time.Time) error {
+// reportProgress publishes the progress of the execution.
+// Reports are dropped rather than holding up the proof generation.
+func (r *round) reportProgress(ctx context.Context, progress prover.Progress) {
+	roundLeaves.WithLabelValues(r.ID).Set(float64(progress.Leaves))
+	roundLeavesPerSecond.WithLabelValues(r.ID).Set(progress.LeavesPerSecond)
+	if r.events == nil {
+		return
+	}
+	select {
+	case r.events <- Event{Type: ProgressReported, RoundID: r.ID, Time: time.Now(), NumLeaves: progress.Leaves, Progress: &progress}:
+	default:
+	}
+}
+
+func (r *round) recoverExecution(ctx context.Context, state *executionState, limit prover.Limit) error {
 	r.executionStarted = r.stateCache.ExecutionStarted
 	close(r.executionStartedChan)
 
+	suite, err := hash.SuiteByName(state.HashSuite)
+	if err != nil {
+		return err
+	}
+
 	if state.Members != nil && state.Statement != nil {
 		r.execution.Members = state.Members
 		r.execution.Statement = state.Statement
 	} else {
-		var err error
 		r.execution.Members, r.execution.Statement, err = r.calcMembersAndStatement()
 		if err != nil {
 			return err
@@ -218,17 +318,18 @@ func (r *round) recoverExecution(ctx context.Context, state *executionState, end
 		}
 	}
 
-	var err error
 	r.execution.NumLeaves, r.execution.NIP, err = prover.GenerateProofRecovery(
 		ctx,
 		r.datadir,
-		hash.GenLabelHashFunc(state.Statement),
-		hash.GenMerkleHashFunc(state.Statement),
-		end,
+		suite.LabelHash(state.Statement, state.LabelDifficulty),
+		suite.MerkleHash(state.Statement),
+		suite.FiatShamir,
+		limit,
 		state.SecurityParam,
 		state.NumLeaves,
 		state.ParkedNodes,
 		r.persistExecution,
+		r.reportProgress,
 	)
 	if err != nil {
 		return err
@@ -236,6 +337,9 @@ func (r *round) recoverExecution(ctx context.Context, state *executionState, end
 	if err := r.saveState(); err != nil {
 		return err
 	}
+	if r.nodeIDs, err = r.registeredNodeIDs(); err != nil {
+		return err
+	}
 
 	close(r.executionEndedChan)
 
@@ -277,9 +381,11 @@ func (r *round) state() (*roundState, error) {
 	if err := load(filename, s); err != nil {
 		return nil, err
 	}
-	if r.execution.SecurityParam != s.Execution.SecurityParam {
-		return nil, errors.New("SecurityParam config mismatch")
-	}
+	// The round keeps the parameters it was opened with, regardless of the current config.
+	r.execution.SecurityParam = s.Execution.SecurityParam
+	r.execution.LabelDifficulty = s.Execution.LabelDifficulty
+	r.execution.HashSuite = s.Execution.HashSuite
+	r.execution.FixedLeaves = s.Execution.FixedLeaves
 	r.stateCache = s
 
 	return s, nil
@@ -319,6 +425,8 @@ func (r *round) calcMembersAndStatement() ([][]byte, []byte, error) {
 }
 
 func (r *round) teardown(cleanup bool) error {
+	roundLeaves.DeleteLabelValues(r.ID)
+	roundLeavesPerSecond.DeleteLabelValues(r.ID)
 	if err := r.challengesDb.Close(); err != nil {
 		return err
 	}
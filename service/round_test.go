@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/spacemeshos/poet/hash"
 	"github.com/spacemeshos/poet/prover"
 )
 
@@ -56,7 +57,7 @@ func TestRound_Recovery(t *testing.T) {
 	req.Equal(len(challenges), r1.numChallenges())
 	req.False(r1.isEmpty())
 
-	req.NoError(r1.execute(ctx, time.Now().Add(duration), prover.LowestMerkleMinMemoryLayer))
+	req.NoError(r1.execute(ctx, prover.Limit{Deadline: time.Now().Add(duration)}, prover.LowestMerkleMinMemoryLayer))
 	req.NoError(r1.teardown(true))
 
 	// Execute r2, and request shutdown before completion.
@@ -73,7 +74,7 @@ func TestRound_Recovery(t *testing.T) {
 	req.False(r2.isEmpty())
 
 	stop()
-	req.ErrorIs(r2.execute(ctx, time.Now().Add(duration), prover.LowestMerkleMinMemoryLayer), prover.ErrShutdownRequested)
+	req.ErrorIs(r2.execute(ctx, prover.Limit{Deadline: time.Now().Add(duration)}, prover.LowestMerkleMinMemoryLayer), prover.ErrShutdownRequested)
 	req.NoError(r2.teardown(false))
 
 	// Recover r2 execution, and request shutdown before completion.
@@ -88,7 +89,7 @@ func TestRound_Recovery(t *testing.T) {
 	req.NoError(err)
 
 	stop()
-	req.ErrorIs(r2recovery1.recoverExecution(ctx, state.Execution, time.Now().Add(duration)), prover.ErrShutdownRequested)
+	req.ErrorIs(r2recovery1.recoverExecution(ctx, state.Execution, prover.Limit{Deadline: time.Now().Add(duration)}), prover.ErrShutdownRequested)
 	req.NoError(r2recovery1.teardown(false))
 
 	// Recover r2 execution again, and let it complete.
@@ -101,7 +102,7 @@ func TestRound_Recovery(t *testing.T) {
 	state, err = r2recovery2.state()
 	req.NoError(err)
 
-	req.NoError(r2recovery2.recoverExecution(ctx, state.Execution, time.Now().Add(duration)))
+	req.NoError(r2recovery2.recoverExecution(ctx, state.Execution, prover.Limit{Deadline: time.Now().Add(duration)}))
 	req.NoError(r2recovery2.teardown(true))
 }
 
@@ -145,6 +146,13 @@ func TestRound_State(t *testing.T) {
 	req.Len(challenges, r.numChallenges())
 	req.False(r.isEmpty())
 
+	// Withdrawing a challenge, even twice, counts it out once.
+	req.NoError(r.withdraw(challenges[0]))
+	req.NoError(r.withdraw(challenges[0]))
+	req.Equal(len(challenges)-1, r.numChallenges())
+	req.NoError(r.submit(challenges[0], challenges[0]))
+	req.Len(challenges, r.numChallenges())
+
 	req.Nil(r.stateCache)
 	state, err = r.state()
 	req.NoError(err)
@@ -163,7 +171,7 @@ func TestRound_State(t *testing.T) {
 	// Execute the round, and request shutdown before completion.
 	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*100)
 	defer cancel()
-	req.ErrorIs(r.execute(ctx, time.Now().Add(time.Hour), prover.LowestMerkleMinMemoryLayer), prover.ErrShutdownRequested)
+	req.ErrorIs(r.execute(ctx, prover.Limit{Deadline: time.Now().Add(time.Hour)}, prover.LowestMerkleMinMemoryLayer), prover.ErrShutdownRequested)
 	req.False(r.isOpen())
 	req.False(r.opened.IsZero())
 	req.False(r.executionStarted.IsZero())
@@ -202,7 +210,7 @@ func TestRound_State(t *testing.T) {
 	req.Equal(prevState, state)
 
 	// Recover execution.
-	req.NoError(r.recoverExecution(ctx, state.Execution, time.Now().Add(200*time.Millisecond)))
+	req.NoError(r.recoverExecution(ctx, state.Execution, prover.Limit{Deadline: time.Now().Add(200 * time.Millisecond)}))
 
 	req.False(r.executionStarted.IsZero())
 	proof, err := r.proof(false)
@@ -226,3 +234,28 @@ func TestRound_State(t *testing.T) {
 	req.EqualError(err, fmt.Sprintf("file is missing: %v", filepath.Join(r.datadir, roundStateFileBaseName)))
 	req.Nil(state)
 }
+
+func TestRound_KeepsParameters(t *testing.T) {
+	req := require.New(t)
+	tempdir := t.TempDir()
+
+	r, err := newRound(tempdir, 0)
+	req.NoError(err)
+	r.execution.SecurityParam = 5
+	r.execution.LabelDifficulty = 3
+	r.execution.HashSuite = hash.SHA3
+	r.execution.FixedLeaves = 1000
+	req.NoError(r.open())
+	req.NoError(r.teardown(false))
+
+	// The recovered round keeps the parameters it was opened with.
+	r, err = newRound(tempdir, 0)
+	req.NoError(err)
+	defer r.teardown(true)
+	_, err = r.state()
+	req.NoError(err)
+	req.Equal(uint8(5), r.execution.SecurityParam)
+	req.Equal(uint32(3), r.execution.LabelDifficulty)
+	req.Equal(hash.SHA3, r.execution.HashSuite)
+	req.EqualValues(1000, r.execution.FixedLeaves)
+}
@@ -3,21 +3,28 @@ package service
 import (
 	"context"
 	"crypto/ed25519"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	mshared "github.com/spacemeshos/merkle-tree/shared"
+	"github.com/syndtr/goleveldb/leveldb"
 	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 
+	"github.com/spacemeshos/poet/gateway"
 	"github.com/spacemeshos/poet/gateway/challenge_verifier"
+	"github.com/spacemeshos/poet/hash"
 	"github.com/spacemeshos/poet/logging"
 	"github.com/spacemeshos/poet/prover"
 	"github.com/spacemeshos/poet/shared"
@@ -33,14 +40,25 @@ type Config struct {
 	Reset             bool          `long:"reset" description:"whether to reset the service state by deleting the datadir"`
 	GatewayAddresses  []string      `long:"gateway" description:"addresses of Spacemesh gateway nodes"`
 	ConnAcksThreshold uint          `long:"conn-acks" description:"number of required successful connections to Spacemesh gateway nodes"`
+	VerifierQuorum    uint          `long:"verifier-quorum" description:"number of Spacemesh gateway nodes required to agree on the verification of a challenge. If at most 1, the gateways are queried one at a time"`
+	FixedLeaves       uint64        `long:"fixed-leaves" description:"number of leaves of the proving tree of each round. When set, rounds execute until reaching it rather than until the round end, making proofs independent of the machine speed"`
+	HashSuite         string        `long:"hash-suite" description:"name of the hash suite used to generate the proofs of new rounds (sha256, sha3-256)"`
+	SecurityParam     uint8         `long:"security-param" description:"number of leaves proven by the proofs of new rounds (T)"`
+	LabelDifficulty   uint32        `long:"label-difficulty" description:"number of recursive hashes per label of the proofs of new rounds"`
+	LocalVerifier     bool          `long:"local-verifier" description:"whether to verify the signatures of the challenges locally rather than with Spacemesh gateway nodes"`
+	AllowedNodeKeys   []string      `long:"allowed-node-key" description:"hex-encoded ed25519 key of a node allowed to submit challenges to the local verifier. All nodes are allowed if none is given"`
+
+	RegistrationsRetention uint32 `long:"registrations-retention" description:"number of rounds, counting back from the open round, whose registrations are kept. All are kept if 0"`
+
+	VerifierCacheSize       int           `long:"verifier-cache-size" description:"maximum number of challenge verification results cached on disk"`
+	VerifierCacheValidTTL   time.Duration `long:"verifier-cache-valid-ttl" description:"how long the verification results of valid challenges are cached. They are not cached if 0"`
+	VerifierCacheInvalidTTL time.Duration `long:"verifier-cache-invalid-ttl" description:"how long the verification results of invalid challenges are cached. They are not cached if 0"`
 }
 
 // estimatedLeavesPerSecond is used to computed estimated height of the proving tree
 // in the epoch, which is used for cache estimation.
 const estimatedLeavesPerSecond = 1 << 17
 
-const ChallengeVerifierCacheSize = 1024
-
 // Service orchestrates rounds functionality
 // It is responsible for accepting challenges, generating a proof from their hash digest and persisting it.
 //
@@ -57,12 +75,24 @@ type Service struct {
 	datadir        string
 	genesis        time.Time
 	minMemoryLayer uint
+	// hashSuite is the hash suite of the rounds opened from now on.
+	// Recovered rounds keep the suite they were opened with.
+	hashSuite *hash.Suite
 
 	// openRound is the round which is currently open for accepting challenges registration from miners.
 	// At any given time there is one single open round.
-	openRound         *round
-	executingRounds   map[string]struct{}
+	openRound       *round
+	executingRounds map[string]*RoundInfo
+	// executing are the executing rounds, whose challenges can be looked up until they are torn down.
+	executing         map[string]*round
 	challengeVerifier atomic.Value // holds challenge_verifier.Verifier
+	// registrations records the provenance of the registered challenges. It is opened by Run.
+	registrations *registrationsDb
+
+	// subscribers receive the round lifecycle events. They are owned by the Service loop.
+	subscribers []*subscriber
+	// stopped is closed when the Service loop exits.
+	stopped chan struct{}
 
 	PubKey  ed25519.PublicKey
 	privKey ed25519.PrivateKey
@@ -78,6 +108,33 @@ type InfoResponse struct {
 	ExecutingRoundsIds []string
 }
 
+// RoundStatus is the state of a round, as recorded in its state file.
+type RoundStatus int
+
+const (
+	RoundOpen RoundStatus = iota + 1
+	RoundExecuting
+	RoundExecuted
+)
+
+// RoundInfo describes a round and its progress.
+type RoundInfo struct {
+	ID     string
+	Epoch  uint32
+	Status RoundStatus
+	// Opened is when the round opened for registrations.
+	// It is unknown once the round is executed.
+	Opened           time.Time
+	ExecutionStarted time.Time
+	ExecutionEnd     time.Time
+	Members          int
+	// Leaves is the number of leaves of the proving tree.
+	// For an executing round, it is the number of leaves persisted at the last checkpoint.
+	Leaves uint64
+	// Progress is the last reported progress of an executing round.
+	Progress *prover.Progress
+}
+
 type PoetProof struct {
 	N         uint
 	Statement []byte
@@ -89,6 +146,7 @@ var (
 	ErrAlreadyStarted            = errors.New("already started")
 	ErrChallengeAlreadySubmitted = errors.New("challenge is already submitted")
 	ErrRoundNotFinished          = errors.New("round is not finished yet")
+	ErrRoundNotExecuting         = errors.New("round is not executing")
 )
 
 // NewService creates a new instance of Poet Service.
@@ -98,12 +156,11 @@ func NewService(ctx context.Context, cfg *Config, datadir string) (*Service, err
 	if err != nil {
 		return nil, err
 	}
-	minMemoryLayer := int(mshared.RootHeightFromWidth(
-		uint64(cfg.EpochDuration.Seconds()*estimatedLeavesPerSecond),
-	)) - int(cfg.MemoryLayers)
-	if minMemoryLayer < prover.LowestMerkleMinMemoryLayer {
-		minMemoryLayer = prover.LowestMerkleMinMemoryLayer
+	suite, err := hash.SuiteByName(cfg.HashSuite)
+	if err != nil {
+		return nil, err
 	}
+	minMemoryLayer := MinMemoryLayer(cfg.EpochDuration, cfg.MemoryLayers)
 	logging.FromContext(ctx).Sugar().Infof("creating poet service. min memory layer: %v. genesis: %s", minMemoryLayer, cfg.Genesis)
 
 	if cfg.Reset {
@@ -143,10 +200,13 @@ func NewService(ctx context.Context, cfg *Config, datadir string) (*Service, err
 		proofs:          make(chan shared.ProofMessage, 1),
 		commands:        cmds,
 		cfg:             cfg,
-		minMemoryLayer:  uint(minMemoryLayer),
+		minMemoryLayer:  minMemoryLayer,
+		hashSuite:       suite,
 		genesis:         genesis,
 		datadir:         datadir,
-		executingRounds: make(map[string]struct{}),
+		executingRounds: make(map[string]*RoundInfo),
+		executing:       make(map[string]*round),
+		stopped:         make(chan struct{}),
 		privKey:         privateKey,
 		PubKey:          privateKey.Public().(ed25519.PublicKey),
 	}
@@ -156,6 +216,18 @@ func NewService(ctx context.Context, cfg *Config, datadir string) (*Service, err
 	return s, nil
 }
 
+// MinMemoryLayer returns the layer from which the proving tree of a proof generated
+// for `duration` is cached in-memory, so that its top `memoryLayers` layers are held in-memory.
+func MinMemoryLayer(duration time.Duration, memoryLayers uint) uint {
+	minMemoryLayer := int(mshared.RootHeightFromWidth(
+		uint64(duration.Seconds()*estimatedLeavesPerSecond),
+	)) - int(memoryLayers)
+	if minMemoryLayer < prover.LowestMerkleMinMemoryLayer {
+		minMemoryLayer = prover.LowestMerkleMinMemoryLayer
+	}
+	return uint(minMemoryLayer)
+}
+
 type roundResult struct {
 	round *round
 	err   error
@@ -169,6 +241,9 @@ func (s *Service) loop(ctx context.Context, roundsToResume []*round) error {
 	logger := logging.FromContext(ctx).Named("worker")
 	ctx = logging.NewContext(ctx, logger)
 
+	defer close(s.stopped)
+	defer s.closeSubscribers()
+
 	// Make sure there is an open round
 	if s.openRound == nil {
 		epoch := uint32(0)
@@ -186,14 +261,30 @@ func (s *Service) loop(ctx context.Context, roundsToResume []*round) error {
 	defer eg.Wait()
 
 	roundResults := make(chan roundResult, 1)
+	roundEvents := make(chan Event, 1)
 
 	// Resume recovered rounds
 	for _, round := range roundsToResume {
 		round := round
-		s.executingRounds[round.ID] = struct{}{}
-		end := s.roundEndTime(round)
+		members := len(round.stateCache.Execution.Members)
+		if round.stateCache.Execution.Members == nil {
+			members = round.numChallenges()
+		}
+		s.executingRounds[round.ID] = &RoundInfo{
+			ID:               round.ID,
+			Epoch:            round.Epoch(),
+			Status:           RoundExecuting,
+			Opened:           round.stateCache.Opened,
+			ExecutionStarted: round.stateCache.ExecutionStarted,
+			ExecutionEnd:     s.roundEndTime(round),
+			Members:          members,
+			Leaves:           round.stateCache.Execution.NumLeaves,
+		}
+		s.executing[round.ID] = round
+		round.events = roundEvents
+		limit := s.roundLimit(round)
 		eg.Go(func() error {
-			err := round.recoverExecution(ctx, round.stateCache.Execution, end)
+			err := round.recoverExecution(ctx, round.stateCache.Execution, limit)
 			if err := round.teardown(err == nil); err != nil {
 				logger.Warn("round teardown failed", zap.Error(err))
 			}
@@ -207,13 +298,29 @@ func (s *Service) loop(ctx context.Context, roundsToResume []*round) error {
 		case cmd := <-s.commands:
 			cmd(s)
 
+		case ev := <-roundEvents:
+			if info, ok := s.executingRounds[ev.RoundID]; ok {
+				switch ev.Type {
+				case CheckpointPersisted:
+					info.Leaves = ev.NumLeaves
+				case ProgressReported:
+					info.Progress = ev.Progress
+				}
+			}
+			s.publish(ev)
+
 		case result := <-roundResults:
 			if result.err == nil {
-				s.reportNewProof(result.round.ID, result.round.execution)
+				if err := s.reportNewProof(result.round.ID, result.round.execution, result.round.nodeIDs); err != nil {
+					logger.Error("failed to report proof", zap.Error(err), zap.String("round", result.round.ID))
+				}
+				s.publish(Event{Type: ProofReady, RoundID: result.round.ID, NumLeaves: result.round.execution.NumLeaves})
 			} else {
 				logger.Error("round execution failed", zap.Error(result.err), zap.String("round", result.round.ID))
+				s.publish(Event{Type: RoundFailed, RoundID: result.round.ID, Err: result.err})
 			}
 			delete(s.executingRounds, result.round.ID)
+			delete(s.executing, result.round.ID)
 
 		case <-s.timer:
 			round := s.openRound
@@ -222,12 +329,24 @@ func (s *Service) loop(ctx context.Context, roundsToResume []*round) error {
 				return fmt.Errorf("failed to open new round: %w", err)
 			}
 			s.openRound = newRound
-			s.executingRounds[round.ID] = struct{}{}
+			s.executingRounds[round.ID] = &RoundInfo{
+				ID:               round.ID,
+				Epoch:            round.Epoch(),
+				Status:           RoundExecuting,
+				Opened:           round.opened,
+				ExecutionStarted: time.Now(),
+				ExecutionEnd:     s.roundEndTime(round),
+				Members:          round.numChallenges(),
+			}
+			s.executing[round.ID] = round
+			round.events = roundEvents
+			s.publish(Event{Type: ExecutionStarted, RoundID: round.ID})
+			s.publish(Event{Type: RoundOpened, RoundID: newRound.ID})
 
-			end := s.roundEndTime(round)
+			limit := s.roundLimit(round)
 			minMemoryLayer := s.minMemoryLayer
 			eg.Go(func() error {
-				err := round.execute(ctx, end, minMemoryLayer)
+				err := round.execute(ctx, limit, minMemoryLayer)
 				if err := round.teardown(err == nil); err != nil {
 					logger.Warn("round teardown failed", zap.Error(err))
 				}
@@ -247,11 +366,25 @@ func (s *Service) loop(ctx context.Context, roundsToResume []*round) error {
 }
 
 func (s *Service) roundStartTime(round *round) time.Time {
-	return s.genesis.Add(s.cfg.PhaseShift).Add(s.cfg.EpochDuration * time.Duration(round.Epoch()))
+	start, _ := s.ExecutionWindow(round.Epoch())
+	return start
 }
 
 func (s *Service) roundEndTime(round *round) time.Time {
-	return s.roundStartTime(round).Add(s.cfg.EpochDuration).Add(-s.cfg.CycleGap)
+	_, end := s.ExecutionWindow(round.Epoch())
+	return end
+}
+
+// roundLimit returns when the execution of the round stops.
+// The leaf count is the one the round was opened with, regardless of the current config.
+func (s *Service) roundLimit(round *round) prover.Limit {
+	return prover.Limit{Deadline: s.roundEndTime(round), Leaves: round.execution.FixedLeaves}
+}
+
+// ExecutionWindow returns the scheduled start and end of the execution of the round of the given epoch.
+func (s *Service) ExecutionWindow(epoch uint32) (start, end time.Time) {
+	start = s.genesis.Add(s.cfg.PhaseShift).Add(s.cfg.EpochDuration * time.Duration(epoch))
+	return start, start.Add(s.cfg.EpochDuration).Add(-s.cfg.CycleGap)
 }
 
 func (s *Service) scheduleRound(ctx context.Context, round *round) <-chan time.Time {
@@ -266,11 +399,17 @@ func (s *Service) scheduleRound(ctx context.Context, round *round) <-chan time.T
 // Run starts the Service's actor event loop.
 // It stops when the `ctx` is canceled.
 func (s *Service) Run(ctx context.Context) error {
+	registrations, err := openRegistrationsDb(filepath.Join(s.datadir, "registrationsDb"))
+	if err != nil {
+		return err
+	}
+	defer registrations.close()
+	s.registrations = registrations
+
 	var toResume []*round
 	if s.cfg.NoRecovery {
 		logging.FromContext(ctx).Info("Recovery is disabled")
 	} else {
-		var err error
 		s.openRound, toResume, err = s.recover(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to recover: %v", err)
@@ -335,7 +474,13 @@ func (s *Service) recover(ctx context.Context) (open *round, executing []*round,
 		}
 
 		if state.isExecuted() {
-			s.reportNewProof(r.ID, state.Execution)
+			nodeIDs, err := r.registeredNodeIDs()
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to read registrations: %w", err)
+			}
+			if err := s.reportNewProof(r.ID, state.Execution, nodeIDs); err != nil {
+				return nil, nil, err
+			}
 			continue
 		}
 
@@ -370,6 +515,7 @@ type SubmitResult struct {
 
 func (s *Service) Submit(ctx context.Context, challenge, signature []byte) (*SubmitResult, error) {
 	if !s.Started() {
+		submissions.WithLabelValues("", submissionResult(ErrNotStarted)).Inc()
 		return nil, ErrNotStarted
 	}
 	logger := logging.FromContext(ctx)
@@ -380,6 +526,7 @@ func (s *Service) Submit(ctx context.Context, challenge, signature []byte) (*Sub
 	result, err := verifier.Verify(ctx, challenge, signature)
 	if err != nil {
 		logger.Debug("challenge verification failed", zap.Error(err))
+		submissions.WithLabelValues("", submissionResult(err)).Inc()
 		return nil, err
 	}
 	logger.Debug("verified challenge",
@@ -393,9 +540,29 @@ func (s *Service) Submit(ctx context.Context, challenge, signature []byte) (*Sub
 	}
 	done := make(chan response, 1)
 	s.commands <- func(s *Service) {
+		err := s.openRound.submit(result.NodeId, result.Hash)
+		if err == nil {
+			registration := &Registration{
+				NodeID:      result.NodeId,
+				Hash:        result.Hash,
+				Challenge:   challenge,
+				Signature:   signature,
+				Verifiers:   result.Verifiers,
+				VerifiedAt:  result.VerifiedAt,
+				SubmittedAt: time.Now(),
+			}
+			// A challenge is only registered along with its provenance.
+			if err = s.registrations.add(s.openRound.ID, registration); err != nil {
+				logger.Error("failed to record the registration", zap.Error(err))
+				if err := s.openRound.withdraw(result.NodeId); err != nil {
+					logger.Error("failed to withdraw the challenge", zap.Error(err))
+				}
+				err = fmt.Errorf("failed to record the registration: %w", err)
+			}
+		}
 		done <- response{
 			round: s.openRound.ID,
-			err:   s.openRound.submit(result.NodeId, result.Hash),
+			err:   err,
 			end:   s.roundEndTime(s.openRound),
 		}
 		close(done)
@@ -403,12 +570,13 @@ func (s *Service) Submit(ctx context.Context, challenge, signature []byte) (*Sub
 
 	select {
 	case resp := <-done:
+		submissions.WithLabelValues(resp.round, submissionResult(resp.err)).Inc()
 		switch {
 		case resp.err == nil:
 			logger.Debug("submitted challenge for round", zap.String("round", resp.round))
 		case errors.Is(resp.err, ErrChallengeAlreadySubmitted):
 		case resp.err != nil:
-			return nil, err
+			return nil, resp.err
 		}
 		return &SubmitResult{
 			Round:    resp.round,
@@ -441,6 +609,160 @@ func (s *Service) Info(ctx context.Context) (*InfoResponse, error) {
 	}
 }
 
+// Rounds returns the open round and the executing rounds.
+func (s *Service) Rounds(ctx context.Context) ([]*RoundInfo, error) {
+	resp := make(chan []*RoundInfo, 1)
+	s.commands <- func(s *Service) {
+		defer close(resp)
+		rounds := make([]*RoundInfo, 0, len(s.executingRounds)+1)
+		for _, info := range s.executingRounds {
+			info := *info
+			rounds = append(rounds, &info)
+		}
+		_, end := s.ExecutionWindow(s.openRound.Epoch())
+		rounds = append(rounds, &RoundInfo{
+			ID:           s.openRound.ID,
+			Epoch:        s.openRound.Epoch(),
+			Status:       RoundOpen,
+			Opened:       s.openRound.opened,
+			ExecutionEnd: end,
+			Members:      s.openRound.numChallenges(),
+		})
+		resp <- rounds
+	}
+	select {
+	case rounds := <-resp:
+		slices.SortFunc(rounds, func(a, b *RoundInfo) bool { return a.Epoch < b.Epoch })
+		return rounds, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// RoundProgress returns the last reported progress of an executing round, or nil if none was reported yet.
+func (s *Service) RoundProgress(ctx context.Context, roundID string) (*prover.Progress, error) {
+	type response struct {
+		progress *prover.Progress
+		err      error
+	}
+	resp := make(chan response, 1)
+	s.commands <- func(s *Service) {
+		defer close(resp)
+		info, ok := s.executingRounds[roundID]
+		if !ok {
+			resp <- response{err: ErrRoundNotExecuting}
+			return
+		}
+		var progress *prover.Progress
+		if info.Progress != nil {
+			p := *info.Progress
+			progress = &p
+		}
+		resp <- response{progress: progress}
+	}
+	select {
+	case resp := <-resp:
+		return resp.progress, resp.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Ping checks that the service is responsive, processing its commands.
+func (s *Service) Ping(ctx context.Context) error {
+	done := make(chan struct{})
+	select {
+	case s.commands <- func(*Service) { close(done) }:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Registrations returns the challenges registered in a round, with the provenance of their verification.
+func (s *Service) Registrations(ctx context.Context, roundID string) ([]*Registration, error) {
+	type response struct {
+		registrations []*Registration
+		err           error
+	}
+	resp := make(chan response, 1)
+	s.commands <- func(s *Service) {
+		defer close(resp)
+		registrations, err := s.registrations.list(roundID)
+		resp <- response{registrations, err}
+	}
+	select {
+	case resp := <-resp:
+		return resp.registrations, resp.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// RoundRegistration is the registration of a node in the open or an executing round.
+type RoundRegistration struct {
+	RoundID string
+	Status  RoundStatus
+	// Hash is the challenge hash stored in the round.
+	Hash []byte
+	// RoundEnd is the scheduled end of the execution of the round.
+	RoundEnd time.Time
+}
+
+// NodeRegistrations returns the registrations of the node in the open round and the executing rounds,
+// as stored in their challenges DBs.
+func (s *Service) NodeRegistrations(ctx context.Context, nodeID []byte) ([]*RoundRegistration, error) {
+	type response struct {
+		registrations []*RoundRegistration
+		err           error
+	}
+	resp := make(chan response, 1)
+	s.commands <- func(s *Service) {
+		defer close(resp)
+		var registrations []*RoundRegistration
+		lookup := func(round *round, status RoundStatus) error {
+			hash, err := round.challenge(nodeID)
+			switch {
+			// An executing round is torn down once its proof is generated.
+			case errors.Is(err, ErrNotFound), errors.Is(err, leveldb.ErrClosed):
+				return nil
+			case err != nil:
+				return fmt.Errorf("failed to look up the challenge of round %s: %w", round.ID, err)
+			}
+			registrations = append(registrations, &RoundRegistration{
+				RoundID:  round.ID,
+				Status:   status,
+				Hash:     hash,
+				RoundEnd: s.roundEndTime(round),
+			})
+			return nil
+		}
+		for _, round := range s.executing {
+			if err := lookup(round, RoundExecuting); err != nil {
+				resp <- response{err: err}
+				return
+			}
+		}
+		err := lookup(s.openRound, RoundOpen)
+		resp <- response{registrations, err}
+	}
+	select {
+	case resp := <-resp:
+		if resp.err != nil {
+			return nil, resp.err
+		}
+		slices.SortFunc(resp.registrations, func(a, b *RoundRegistration) bool { return a.RoundEnd.Before(b.RoundEnd) })
+		return resp.registrations, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
 // newRound creates a new round with the given epoch.
 func (s *Service) newRound(ctx context.Context, epoch uint32) (*round, error) {
 	roundsDir := filepath.Join(s.datadir, "rounds")
@@ -448,39 +770,154 @@ func (s *Service) newRound(ctx context.Context, epoch uint32) (*round, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a new round: %w", err)
 	}
+	r.execution.HashSuite = s.hashSuite.Name
+	if s.cfg.SecurityParam != 0 {
+		r.execution.SecurityParam = s.cfg.SecurityParam
+	}
+	r.execution.LabelDifficulty = hash.LabelDifficultyOrDefault(s.cfg.LabelDifficulty)
+	r.execution.FixedLeaves = s.cfg.FixedLeaves
 	if err := r.open(); err != nil {
 		return nil, fmt.Errorf("failed to open round: %w", err)
 	}
 
 	logging.FromContext(ctx).Info("Round opened", zap.String("ID", r.ID))
+	s.pruneRegistrations(ctx, epoch)
 	return r, nil
 }
 
-func (s *Service) reportNewProof(round string, execution *executionState) {
-	s.proofs <- shared.ProofMessage{
+// pruneRegistrations deletes the registrations of the rounds older than the retention, counting back from `epoch`.
+func (s *Service) pruneRegistrations(ctx context.Context, epoch uint32) {
+	if s.cfg.RegistrationsRetention == 0 || epoch < s.cfg.RegistrationsRetention {
+		return
+	}
+	logger := logging.FromContext(ctx)
+	pruned, err := s.registrations.prune(epoch - s.cfg.RegistrationsRetention + 1)
+	if err != nil {
+		logger.Warn("failed to prune registrations", zap.Error(err))
+		return
+	}
+	if pruned > 0 {
+		logger.Info("pruned registrations", zap.Int("count", pruned))
+	}
+}
+
+func (s *Service) reportNewProof(round string, execution *executionState, nodeIDs [][]byte) error {
+	start, end := s.ExecutionWindow(execution.Epoch)
+	msg := shared.ProofMessage{
+		Version: shared.ProofMessageVersion,
 		Proof: shared.Proof{
 			MerkleProof: *execution.NIP,
 			Members:     execution.Members,
 			NumLeaves:   execution.NumLeaves,
 		},
-		ServicePubKey: s.PubKey,
-		RoundID:       round,
+		ServicePubKey:   s.PubKey,
+		RoundID:         round,
+		Epoch:           execution.Epoch,
+		RoundStart:      uint64(start.Unix()),
+		RoundEnd:        uint64(end.Unix()),
+		Statement:       execution.Statement,
+		NodeIDs:         nodeIDs,
+		HashSuite:       execution.HashSuite,
+		SecurityParam:   execution.SecurityParam,
+		LabelDifficulty: execution.LabelDifficulty,
 	}
+	signed, err := msg.SignedBytes()
+	if err != nil {
+		return fmt.Errorf("failed to encode proof for signing: %w", err)
+	}
+	msg.Signature = ed25519.Sign(s.privKey, signed)
+	s.proofs <- msg
+	return nil
 }
 
-// CreateChallengeVerifier creates a verifier connected to provided gateways.
-// The verifier caches verification results and round-robins between gateways if
-// verification fails.
-func CreateChallengeVerifier(gateways []*grpc.ClientConn) (challenge_verifier.Verifier, error) {
-	if len(gateways) == 0 {
-		return nil, fmt.Errorf("need at least one gateway")
+// OpenChallengeVerifierCache opens the cache of the challenge verification results in `datadir`.
+func OpenChallengeVerifierCache(cfg *Config, datadir string) (*challenge_verifier.Cache, error) {
+	return challenge_verifier.OpenCache(
+		filepath.Join(datadir, "verifierCache"),
+		challenge_verifier.WithCacheSize(cfg.VerifierCacheSize),
+		challenge_verifier.WithCacheTTL(cfg.VerifierCacheValidTTL, cfg.VerifierCacheInvalidTTL),
+	)
+}
+
+// CreateLocalChallengeVerifier creates a verifier checking the signatures of the challenges locally.
+// It accepts the challenges of the nodes of the given hex-encoded keys, or of any node if none is given.
+// The verifier caches verification results in `cache`.
+func CreateLocalChallengeVerifier(allowedNodeKeys []string, cache *challenge_verifier.Cache) (challenge_verifier.Verifier, error) {
+	policy := challenge_verifier.AllowAllKeys
+	fingerprint := []string{"local"}
+	if len(allowedNodeKeys) != 0 {
+		keys := make([]ed25519.PublicKey, 0, len(allowedNodeKeys))
+		for _, encoded := range allowedNodeKeys {
+			key, err := hex.DecodeString(encoded)
+			if err != nil {
+				return nil, fmt.Errorf("invalid node key %q: %w", encoded, err)
+			}
+			if len(key) != ed25519.PublicKeySize {
+				return nil, fmt.Errorf("invalid node key %q: must be %d bytes long", encoded, ed25519.PublicKeySize)
+			}
+			keys = append(keys, key)
+			fingerprint = append(fingerprint, hex.EncodeToString(key))
+		}
+		policy = challenge_verifier.AllowKeys(keys...)
+	}
+	if err := cache.Bind(verifierFingerprint(fingerprint)); err != nil {
+		return nil, err
 	}
+	return challenge_verifier.NewPersistentCaching(cache, challenge_verifier.NewLocal(policy)), nil
+}
+
+// verifierFingerprint returns the fingerprint of the config of a verifier, described by `parts`
+// whose order doesn't matter beyond the first one, the kind of verifier.
+func verifierFingerprint(parts []string) []byte {
+	slices.Sort(parts[1:])
+	fingerprint := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return fingerprint[:]
+}
+
+// CreateChallengeVerifier creates a verifier connected to the gateways of the manager.
+// The gateways the manager reconnects to later on join the verifier, which cannot verify
+// challenges until enough gateways are connected.
+// The verifier caches verification results in `cache`. If `quorum` is more than 1, it requires
+// `quorum` gateways to agree on the verification, otherwise it sends requests to the fastest healthy gateway,
+// hedging them with another gateway when it is slow or fails.
+func CreateChallengeVerifier(manager *gateway.Manager, quorum uint, cache *challenge_verifier.Cache) (challenge_verifier.Verifier, error) {
+	// Hold back the gateways connecting while the verifier is created.
+	var mu sync.Mutex
+	mu.Lock()
+	defer mu.Unlock()
+	var verifier challenge_verifier.Expandable
+	gateways := manager.Subscribe(func(conn *grpc.ClientConn) {
+		mu.Lock()
+		defer mu.Unlock()
+		if verifier != nil {
+			verifier.Add(challenge_verifier.NewClient(conn))
+		}
+	})
+
 	clients := make([]challenge_verifier.Verifier, 0, len(gateways))
 	for _, target := range gateways {
 		client := challenge_verifier.NewClient(target)
 		clients = append(clients, client)
 	}
-	return challenge_verifier.NewCaching(
-		ChallengeVerifierCacheSize, challenge_verifier.NewRetrying(
-			challenge_verifier.NewRoundRobin(clients), 5, time.Second, 2))
+	if quorum > 1 {
+		var err error
+		verifier, err = challenge_verifier.NewQuorum(clients, quorum)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		verifier = challenge_verifier.NewPool(clients)
+	}
+
+	fingerprint := []string{"gateways"}
+	if quorum > 1 {
+		fingerprint[0] = fmt.Sprintf("gateways, quorum of %d", quorum)
+	}
+	for _, gtw := range manager.Status().Gateways {
+		fingerprint = append(fingerprint, gtw.Address)
+	}
+	if err := cache.Bind(verifierFingerprint(fingerprint)); err != nil {
+		return nil, err
+	}
+	return challenge_verifier.NewPersistentCaching(cache, challenge_verifier.NewRetrying(verifier, 5, time.Second, 2)), nil
 }
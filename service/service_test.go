@@ -2,7 +2,9 @@ package service_test
 
 import (
 	"context"
+	"crypto/ed25519"
 	"crypto/rand"
+	"encoding/hex"
 	"strconv"
 	"testing"
 	"time"
@@ -176,6 +178,15 @@ func TestNewService(t *testing.T) {
 	req.NoError(err)
 	req.Equal(currentRound, info.OpenRoundID)
 
+	rounds, err := s.Rounds(context.Background())
+	req.NoError(err)
+	req.Len(rounds, 1)
+	req.Equal(currentRound, rounds[0].ID)
+	req.Equal(service.RoundOpen, rounds[0].Status)
+	req.Equal(len(challenges), rounds[0].Members)
+	_, err = s.RoundProgress(context.Background(), currentRound)
+	req.ErrorIs(err, service.ErrRoundNotExecuting)
+
 	// Wait for round to start execution.
 	req.Eventually(func() bool {
 		info, err := s.Info(context.Background())
@@ -188,6 +199,16 @@ func TestNewService(t *testing.T) {
 		return false
 	}, cfg.EpochDuration*2, time.Millisecond*100)
 
+	rounds, err = s.Rounds(context.Background())
+	req.NoError(err)
+	idx := slices.IndexFunc(rounds, func(r *service.RoundInfo) bool { return r.ID == currentRound })
+	req.GreaterOrEqual(idx, 0)
+	req.Equal(service.RoundExecuting, rounds[idx].Status)
+	req.Equal(len(challenges), rounds[idx].Members)
+	req.False(rounds[idx].ExecutionStarted.IsZero())
+	_, err = s.RoundProgress(context.Background(), currentRound)
+	req.NoError(err)
+
 	// Wait for end of execution.
 	req.Eventually(func() bool {
 		info, err := s.Info(context.Background())
@@ -249,3 +270,225 @@ func TestSubmitIdempotency(t *testing.T) {
 	cancel()
 	req.NoError(eg.Wait())
 }
+
+func TestService_Registrations(t *testing.T) {
+	req := require.New(t)
+	cfg := service.Config{
+		Genesis:       time.Now().Add(time.Minute).Format(time.RFC3339),
+		EpochDuration: time.Minute,
+		PhaseShift:    time.Second,
+	}
+	tempdir := t.TempDir()
+	verifiedAt := time.Unix(1234, 5678)
+
+	s, err := service.NewService(context.Background(), &cfg, tempdir)
+	req.NoError(err)
+
+	verifier := mocks.NewMockVerifier(gomock.NewController(t))
+	verifier.EXPECT().Verify(gomock.Any(), []byte("challenge"), []byte("signature")).Times(2).Return(&challenge_verifier.Result{
+		Hash:       []byte("hash"),
+		NodeId:     []byte("node"),
+		Verifiers:  []string{"gateway"},
+		VerifiedAt: verifiedAt,
+	}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var eg errgroup.Group
+	eg.Go(func() error { return s.Run(ctx) })
+	req.NoError(s.Start(context.Background(), verifier))
+
+	result, err := s.Submit(context.Background(), []byte("challenge"), []byte("signature"))
+	req.NoError(err)
+	// Submitting again keeps the first registration.
+	_, err = s.Submit(context.Background(), []byte("challenge"), []byte("signature"))
+	req.NoError(err)
+
+	checkRegistrations := func(s *service.Service) {
+		registrations, err := s.Registrations(context.Background(), result.Round)
+		req.NoError(err)
+		req.Len(registrations, 1)
+		registration := registrations[0]
+		req.Equal([]byte("node"), registration.NodeID)
+		req.Equal([]byte("hash"), registration.Hash)
+		req.Equal([]byte("challenge"), registration.Challenge)
+		req.Equal([]byte("signature"), registration.Signature)
+		req.Equal([]string{"gateway"}, registration.Verifiers)
+		req.True(verifiedAt.Equal(registration.VerifiedAt))
+		req.WithinDuration(time.Now(), registration.SubmittedAt, time.Minute)
+
+		registrations, err = s.Registrations(context.Background(), "unknown")
+		req.NoError(err)
+		req.Empty(registrations)
+
+		// The registration is recorded in the open round.
+		nodeRegistrations, err := s.NodeRegistrations(context.Background(), []byte("node"))
+		req.NoError(err)
+		req.Len(nodeRegistrations, 1)
+		req.Equal(result.Round, nodeRegistrations[0].RoundID)
+		req.Equal(service.RoundOpen, nodeRegistrations[0].Status)
+		req.Equal([]byte("hash"), nodeRegistrations[0].Hash)
+		req.WithinDuration(time.Now().Add(result.RoundEnd), nodeRegistrations[0].RoundEnd, time.Minute)
+
+		nodeRegistrations, err = s.NodeRegistrations(context.Background(), []byte("unknown"))
+		req.NoError(err)
+		req.Empty(nodeRegistrations)
+	}
+	checkRegistrations(s)
+	cancel()
+	req.NoError(eg.Wait())
+
+	// The registrations survive a restart.
+	s, err = service.NewService(context.Background(), &cfg, tempdir)
+	req.NoError(err)
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	eg = errgroup.Group{}
+	eg.Go(func() error { return s.Run(ctx) })
+	checkRegistrations(s)
+	cancel()
+	req.NoError(eg.Wait())
+}
+
+func TestService_PrunesRegistrations(t *testing.T) {
+	req := require.New(t)
+	tempdir := t.TempDir()
+	now := time.Now()
+	run := func(cfg *service.Config, f func(s *service.Service)) {
+		s, err := service.NewService(context.Background(), cfg, tempdir)
+		req.NoError(err)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var eg errgroup.Group
+		eg.Go(func() error { return s.Run(ctx) })
+		f(s)
+		cancel()
+		req.NoError(eg.Wait())
+	}
+	// The open round is of epoch 7, and it starts executing in about 30s.
+	cfg := &service.Config{
+		Genesis:       now.Add(-7*time.Minute - 20*time.Second).Format(time.RFC3339),
+		EpochDuration: time.Minute,
+		PhaseShift:    50 * time.Second,
+	}
+	run(cfg, func(s *service.Service) {
+		verifier := mocks.NewMockVerifier(gomock.NewController(t))
+		verifier.EXPECT().Verify(gomock.Any(), []byte("challenge"), []byte("signature")).Return(&challenge_verifier.Result{
+			Hash:   []byte("hash"),
+			NodeId: []byte("node"),
+		}, nil)
+		req.NoError(s.Start(context.Background(), verifier))
+		result, err := s.Submit(context.Background(), []byte("challenge"), []byte("signature"))
+		req.NoError(err)
+		req.Equal("7", result.Round)
+	})
+
+	// The open round is of epoch 10: the registrations of the rounds 7 to 10 are kept.
+	cfg.Genesis = now.Add(-10*time.Minute - 20*time.Second).Format(time.RFC3339)
+	cfg.NoRecovery = true
+	cfg.RegistrationsRetention = 4
+	run(cfg, func(s *service.Service) {
+		registrations, err := s.Registrations(context.Background(), "7")
+		req.NoError(err)
+		req.Len(registrations, 1)
+	})
+
+	// Only the registrations of the rounds 8 to 10 are kept.
+	cfg.RegistrationsRetention = 3
+	run(cfg, func(s *service.Service) {
+		registrations, err := s.Registrations(context.Background(), "7")
+		req.NoError(err)
+		req.Empty(registrations)
+	})
+}
+
+func TestService_Events(t *testing.T) {
+	req := require.New(t)
+	cfg := &service.Config{
+		Genesis:       time.Now().Add(time.Second).Format(time.RFC3339),
+		EpochDuration: time.Second,
+	}
+
+	s, err := service.NewService(context.Background(), cfg, t.TempDir())
+	req.NoError(err)
+	verifier := mocks.NewMockVerifier(gomock.NewController(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var eg errgroup.Group
+	eg.Go(func() error { return s.Run(ctx) })
+
+	events, err := s.Subscribe(context.Background())
+	req.NoError(err)
+	req.NoError(s.Start(context.Background(), verifier))
+
+	// Round 0 executes and round 1 opens.
+	ev := <-events
+	req.Equal(service.ExecutionStarted, ev.Type)
+	req.Equal("0", ev.RoundID)
+	ev = <-events
+	req.Equal(service.RoundOpened, ev.Type)
+	req.Equal("1", ev.RoundID)
+
+	// Round 0 proof is ready, possibly after round 1 started executing.
+	proof := <-s.ProofsChan()
+	req.Equal("0", proof.RoundID)
+	for ev = range events {
+		if ev.Type == service.ProofReady {
+			break
+		}
+	}
+	req.Equal(service.ProofReady, ev.Type)
+	req.Equal("0", ev.RoundID)
+	req.Equal(proof.NumLeaves, ev.NumLeaves)
+
+	// The subscription ends with the service.
+	cancel()
+	req.NoError(eg.Wait())
+	for range events {
+	}
+	_, err = s.Subscribe(context.Background())
+	req.ErrorIs(err, service.ErrStopped)
+}
+
+func TestCreateLocalChallengeVerifier_ChangedAllowList(t *testing.T) {
+	req := require.New(t)
+	cfg := &service.Config{VerifierCacheSize: 10, VerifierCacheValidTTL: time.Hour, VerifierCacheInvalidTTL: time.Hour}
+	datadir := t.TempDir()
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	req.NoError(err)
+	otherPubKey, otherKey, err := ed25519.GenerateKey(nil)
+	req.NoError(err)
+	challenge := []byte("challenge")
+
+	open := func(allowedNodeKeys ...ed25519.PublicKey) (challenge_verifier.Verifier, *challenge_verifier.Cache) {
+		cache, err := service.OpenChallengeVerifierCache(cfg, datadir)
+		req.NoError(err)
+		t.Cleanup(func() { cache.Close() })
+		var keys []string
+		for _, key := range allowedNodeKeys {
+			keys = append(keys, hex.EncodeToString(key))
+		}
+		verifier, err := service.CreateLocalChallengeVerifier(keys, cache)
+		req.NoError(err)
+		return verifier, cache
+	}
+
+	verifier, cache := open(pubKey, otherPubKey)
+	_, err = verifier.Verify(context.Background(), challenge, challenge_verifier.SignLocal(otherKey, challenge))
+	req.NoError(err)
+	req.NoError(cache.Close())
+
+	// The same allow-list keeps the cached results.
+	verifier, cache = open(otherPubKey, pubKey)
+	req.Equal(1, cache.Stats().Entries)
+	req.NoError(cache.Close())
+
+	// A node removed from the allow-list is no longer accepted from the cache.
+	verifier, cache = open(pubKey)
+	req.Zero(cache.Stats().Entries)
+	_, err = verifier.Verify(context.Background(), challenge, challenge_verifier.SignLocal(otherKey, challenge))
+	req.ErrorIs(err, challenge_verifier.ErrChallengeInvalid)
+	_, err = verifier.Verify(context.Background(), challenge, challenge_verifier.SignLocal(privKey, challenge))
+	req.NoError(err)
+}
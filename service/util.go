@@ -2,32 +2,96 @@ package service
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"os"
+	"path/filepath"
 
 	xdr "github.com/nullstyle/go-xdr/xdr3"
 
 	"github.com/spacemeshos/poet/shared"
 )
 
-var ErrFileIsMissing = errors.New("file is missing")
+var (
+	ErrFileIsMissing          = errors.New("file is missing")
+	ErrFileIsCorrupted        = errors.New("file is corrupted")
+	ErrUnsupportedFileVersion = errors.New("unsupported file version")
+)
+
+// State files start with a header made of the magic bytes,
+// the version of the format and the CRC-32C checksum of the XDR-encoded payload.
+// Files written before the header was introduced hold the bare payload and are of version 0.
+var stateFileMagic = [4]byte{'P', 'O', 'E', 'T'}
+
+const stateFileHeaderSize = len(stateFileMagic) + 4 + 4
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
 
+// migrations upgrade the payload of a state file from the version of their index to the next one.
+// A migration is given the value the payload is decoded into, so that it can tell which state it upgrades.
+// Changing the layout of a persisted state requires appending a migration,
+// which bumps the version of the files written from then on.
+var migrations = []func(payload []byte, v any) ([]byte, error){
+	// 0 -> 1: the header is introduced, and round states record the hash suite,
+	// the label difficulty and the fixed leaf count of the round.
+	migrateRoundStateV0,
+}
+
+// stateFileVersion is the version of the state files written.
+var stateFileVersion = uint32(len(migrations))
+
+// persist writes v to filename atomically: it is written to a temporary file,
+// synced to disk and renamed over filename.
 func persist(filename string, v any) error {
-	var w bytes.Buffer
-	_, err := xdr.Marshal(&w, v)
-	if err != nil {
+	var payload bytes.Buffer
+	if _, err := xdr.Marshal(&payload, v); err != nil {
 		return fmt.Errorf("serialization failure: %v", err)
 	}
 
-	err = os.WriteFile(filename, w.Bytes(), shared.OwnerReadWrite)
-	if err != nil {
+	data := make([]byte, stateFileHeaderSize, stateFileHeaderSize+payload.Len())
+	copy(data, stateFileMagic[:])
+	binary.LittleEndian.PutUint32(data[4:], stateFileVersion)
+	binary.LittleEndian.PutUint32(data[8:], crc32.Checksum(payload.Bytes(), crc32c))
+	data = append(data, payload.Bytes()...)
+
+	if err := writeFileAtomic(filename, data); err != nil {
 		return fmt.Errorf("write to disk failure: %v", err)
 	}
-
 	return nil
 }
 
+func writeFileAtomic(filename string, data []byte) error {
+	tmp := filename + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, shared.OwnerReadWrite)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filename); err != nil {
+		return err
+	}
+
+	// Sync the directory so that the rename survives a crash.
+	dir, err := os.Open(filepath.Dir(filename))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
 func load(filename string, v any) error {
 	data, err := os.ReadFile(filename)
 	if err != nil {
@@ -38,9 +102,30 @@ func load(filename string, v any) error {
 		return fmt.Errorf("failed to read file: %v", err)
 	}
 
-	_, err = xdr.Unmarshal(bytes.NewReader(data), v)
-	if err != nil {
-		return fmt.Errorf("failed to deserialize: %v", err)
+	version, payload := uint32(0), data
+	if bytes.HasPrefix(data, stateFileMagic[:]) {
+		if len(data) < stateFileHeaderSize {
+			return fmt.Errorf("%w: %v: truncated header", ErrFileIsCorrupted, filename)
+		}
+		version = binary.LittleEndian.Uint32(data[4:])
+		payload = data[stateFileHeaderSize:]
+		if version > stateFileVersion {
+			return fmt.Errorf("%w: %v: version %d, latest supported is %d", ErrUnsupportedFileVersion, filename, version, stateFileVersion)
+		}
+		if crc32.Checksum(payload, crc32c) != binary.LittleEndian.Uint32(data[8:]) {
+			return fmt.Errorf("%w: %v: checksum mismatch", ErrFileIsCorrupted, filename)
+		}
+	}
+
+	for ; version < stateFileVersion; version++ {
+		payload, err = migrations[version](payload, v)
+		if err != nil {
+			return fmt.Errorf("failed to migrate %v from version %d: %w", filename, version, err)
+		}
+	}
+
+	if _, err := xdr.Unmarshal(bytes.NewReader(payload), v); err != nil {
+		return fmt.Errorf("%w: %v: failed to deserialize: %v", ErrFileIsCorrupted, filename, err)
 	}
 
 	return nil
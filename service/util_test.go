@@ -0,0 +1,154 @@
+package service
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	xdr "github.com/nullstyle/go-xdr/xdr3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spacemeshos/poet/hash"
+	"github.com/spacemeshos/poet/shared"
+)
+
+func TestPersistAndLoad(t *testing.T) {
+	req := require.New(t)
+	filename := filepath.Join(t.TempDir(), "state.bin")
+
+	state := &roundState{
+		Opened:    time.Unix(1000, 0).UTC(),
+		Execution: &executionState{Epoch: 7, SecurityParam: 150, NumLeaves: 77},
+	}
+	req.NoError(persist(filename, state))
+	req.NoFileExists(filename + ".tmp")
+
+	loaded := &roundState{}
+	req.NoError(load(filename, loaded))
+	req.Equal(state, loaded)
+}
+
+func TestLoad_Legacy(t *testing.T) {
+	req := require.New(t)
+	filename := filepath.Join(t.TempDir(), "state.bin")
+
+	// Files of version 0 hold the bare XDR payload.
+	state := &serviceState{PrivKey: []byte("private key")}
+	var payload bytes.Buffer
+	_, err := xdr.Marshal(&payload, state)
+	req.NoError(err)
+	req.NoError(os.WriteFile(filename, payload.Bytes(), 0o600))
+
+	loaded := &serviceState{}
+	req.NoError(load(filename, loaded))
+	req.Equal(state, loaded)
+}
+
+// writeStateFile writes v to filename as a state file of the given version.
+func writeStateFile(t *testing.T, filename string, version uint32, v any) {
+	var payload bytes.Buffer
+	_, err := xdr.Marshal(&payload, v)
+	require.NoError(t, err)
+	data := make([]byte, stateFileHeaderSize)
+	copy(data, stateFileMagic[:])
+	binary.LittleEndian.PutUint32(data[4:], version)
+	binary.LittleEndian.PutUint32(data[8:], crc32.Checksum(payload.Bytes(), crc32c))
+	require.NoError(t, os.WriteFile(filename, append(data, payload.Bytes()...), 0o600))
+}
+
+func TestLoad_MigratesRoundState(t *testing.T) {
+	req := require.New(t)
+	filename := filepath.Join(t.TempDir(), "state.bin")
+	opened := time.Unix(1000, 0).UTC()
+
+	// Round states of version 0 record neither the hash suite nor the label difficulty.
+	var payload bytes.Buffer
+	_, err := xdr.Marshal(&payload, &roundStateV0{
+		Opened:    opened,
+		Execution: &executionStateV0{Epoch: 7, SecurityParam: 150, NumLeaves: 77},
+	})
+	req.NoError(err)
+	req.NoError(os.WriteFile(filename, payload.Bytes(), 0o600))
+
+	loaded := &roundState{}
+	req.NoError(load(filename, loaded))
+	req.Equal(&roundState{
+		Opened: opened,
+		Execution: &executionState{
+			Epoch:           7,
+			SecurityParam:   150,
+			NumLeaves:       77,
+			HashSuite:       hash.SHA256,
+			LabelDifficulty: hash.LabelHashNestingDepth,
+		},
+	}, loaded)
+}
+
+// The frozen layout of the current version must match the layout of the round states written.
+func TestRoundStateLayout(t *testing.T) {
+	req := require.New(t)
+	state := &roundState{
+		Opened:           time.Unix(1000, 0).UTC(),
+		ExecutionStarted: time.Unix(2000, 0).UTC(),
+		Execution: &executionState{
+			Epoch:           7,
+			SecurityParam:   15,
+			Members:         [][]byte{[]byte("member")},
+			Statement:       []byte("statement"),
+			NumLeaves:       77,
+			NIP:             &shared.MerkleProof{Root: []byte("root")},
+			HashSuite:       hash.SHA3,
+			LabelDifficulty: 10,
+			FixedLeaves:     1000,
+		},
+	}
+	current, err := marshalPayload(state)
+	req.NoError(err)
+	var frozen roundStateV1
+	req.NoError(unmarshalPayload(current, &frozen))
+	encoded, err := marshalPayload(&frozen)
+	req.NoError(err)
+	req.Equal(current, encoded)
+}
+
+func TestLoad_Corrupted(t *testing.T) {
+	req := require.New(t)
+	filename := filepath.Join(t.TempDir(), "state.bin")
+	req.NoError(persist(filename, &serviceState{PrivKey: []byte("private key")}))
+
+	data, err := os.ReadFile(filename)
+	req.NoError(err)
+
+	// Flip a bit of the payload.
+	corrupted := bytes.Clone(data)
+	corrupted[len(corrupted)-1] ^= 1
+	req.NoError(os.WriteFile(filename, corrupted, 0o600))
+	req.ErrorIs(load(filename, &serviceState{}), ErrFileIsCorrupted)
+
+	// Truncate the header.
+	req.NoError(os.WriteFile(filename, data[:stateFileHeaderSize-1], 0o600))
+	req.ErrorIs(load(filename, &serviceState{}), ErrFileIsCorrupted)
+
+	// Truncate a legacy payload.
+	req.NoError(os.WriteFile(filename, data[stateFileHeaderSize:len(data)-2], 0o600))
+	req.ErrorIs(load(filename, &serviceState{}), ErrFileIsCorrupted)
+}
+
+func TestLoad_UnsupportedVersion(t *testing.T) {
+	req := require.New(t)
+	filename := filepath.Join(t.TempDir(), "state.bin")
+	req.NoError(persist(filename, &serviceState{PrivKey: []byte("private key")}))
+
+	data, err := os.ReadFile(filename)
+	req.NoError(err)
+	binary.LittleEndian.PutUint32(data[4:], stateFileVersion+1)
+	req.NoError(os.WriteFile(filename, data, 0o600))
+
+	err = load(filename, &serviceState{})
+	req.ErrorIs(err, ErrUnsupportedFileVersion)
+	req.NotErrorIs(err, ErrFileIsCorrupted)
+}
@@ -1,10 +1,13 @@
 package shared
 
 import (
+	"bytes"
 	"encoding/binary"
+	"errors"
 	"os"
 
 	"github.com/minio/sha256-simd"
+	"github.com/spacemeshos/go-scale"
 	"github.com/spacemeshos/merkle-tree"
 )
 
@@ -19,8 +22,22 @@ const (
 	OwnerReadWrite = os.FileMode(0o600)
 )
 
-// FiatShamir generates a set of indices to include in a non-interactive proof.
+// FiatShamirFunc generates a set of indices to include in a non-interactive proof.
+type FiatShamirFunc func(challenge []byte, spaceSize uint64, securityParam uint8) map[uint64]bool
+
+// FiatShamir generates a set of indices to include in a non-interactive proof, using Sha256.
 func FiatShamir(challenge []byte, spaceSize uint64, securityParam uint8) map[uint64]bool {
+	return fiatShamir(sha256.Sum256, challenge, spaceSize, securityParam)
+}
+
+// MakeFiatShamirFunc returns a FiatShamirFunc deriving the indices with the given hash function.
+func MakeFiatShamirFunc(sum func(data []byte) [32]byte) FiatShamirFunc {
+	return func(challenge []byte, spaceSize uint64, securityParam uint8) map[uint64]bool {
+		return fiatShamir(sum, challenge, spaceSize, securityParam)
+	}
+}
+
+func fiatShamir(sum func(data []byte) [32]byte, challenge []byte, spaceSize uint64, securityParam uint8) map[uint64]bool {
 	ret := make(map[uint64]bool, securityParam)
 	if uint64(securityParam) > spaceSize {
 		for i := uint64(0); i < spaceSize; i++ {
@@ -32,7 +49,7 @@ func FiatShamir(challenge []byte, spaceSize uint64, securityParam uint8) map[uin
 	ib := make([]byte, 4)
 	for i := uint32(0); len(ret) < int(securityParam); i++ {
 		binary.BigEndian.PutUint32(ib, i)
-		result := sha256.Sum256(append(challenge, ib...))
+		result := sum(append(challenge, ib...))
 		id := binary.BigEndian.Uint64(result[:8]) % spaceSize
 		ret[id] = true
 	}
@@ -80,10 +97,63 @@ type Proof struct {
 	NumLeaves uint64
 }
 
+// ProofMessageVersion is the version of the layout of the proof messages created from now on.
+const ProofMessageVersion uint8 = 1
+
+// ProofMessage is the envelope of a proof published by the service:
+// the proof along with the round and the parameters it was generated for.
 type ProofMessage struct {
+	// Version is the version of the layout of the message.
+	// Messages of version 0 predate the envelope and only hold the proof, the service key and the round ID.
+	Version uint8
+
 	Proof
 	ServicePubKey []byte
 	RoundID       string
+
+	// Epoch is the epoch of the round.
+	Epoch uint32
+	// RoundStart and RoundEnd delimit the execution window of the round, in seconds since the Unix epoch.
+	RoundStart uint64
+	RoundEnd   uint64
+	// Statement is the root of the Merkle tree of the members, which the proof is generated for.
+	Statement []byte
+
+	// SecurityParam is the number of leaves proven by the proof and
+	// LabelDifficulty the number of recursive hashes per label.
+	// They are zero for proofs generated before they were configurable, which use T and 100 respectively.
+	SecurityParam   uint8
+	LabelDifficulty uint32
+
+	// HashSuite is the name of the hash suite the proof is generated with.
+	// It is empty for proofs generated before hash suites were introduced, which use SHA-256.
+	HashSuite string
+
+	// NodeIDs are the IDs of the nodes that registered the challenges in Members, in the same order.
+	NodeIDs [][]byte
+
+	// Signature is the signature of the service key over SignedBytes().
+	Signature []byte
+}
+
+// ErrUnsignedProofMessage is returned when signing or verifying a proof message of version 0,
+// which predates the signatures.
+var ErrUnsignedProofMessage = errors.New("proof messages of version 0 are not signed")
+
+// SignedBytes returns the data covered by the service signature of a proof message:
+// the encoded message without the node IDs and the signature.
+func (m *ProofMessage) SignedBytes() ([]byte, error) {
+	if m.Version == 0 {
+		return nil, ErrUnsignedProofMessage
+	}
+	signed := *m
+	signed.NodeIDs = nil
+	signed.Signature = nil
+	var buf bytes.Buffer
+	if _, err := signed.EncodeScale(scale.NewEncoder(&buf)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
 }
 
 type MerkleProof struct {
@@ -0,0 +1,221 @@
+// Package tlsconfig configures TLS for the listeners of poet and its connections to gateways.
+// The certificates are reloaded from disk when they change, without a restart.
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// checkInterval is how often the files are checked for changes.
+var checkInterval = time.Second
+
+// Server configures TLS for listeners, e.g. the RPC and REST listeners.
+type Server struct {
+	Cert     string `long:"cert" description:"path to the PEM certificate of the listeners. They serve TLS if it is set"`
+	Key      string `long:"key" description:"path to the PEM private key of the certificate"`
+	ClientCA string `long:"client-ca" description:"path to PEM CA certificates. If it is set, clients must present a certificate signed by one of them (mutual TLS)"`
+}
+
+// Enabled returns whether the listeners serve TLS.
+// They do if any of the files is set, and Load fails unless both the certificate and its key are set.
+func (s *Server) Enabled() bool {
+	return s.Cert != "" || s.Key != "" || s.ClientCA != ""
+}
+
+// Load returns the credentials of a gRPC server and the TLS config of an HTTP/1.1 server serving TLS.
+func (s *Server) Load() (credentials.TransportCredentials, *tls.Config, error) {
+	if s.Cert == "" || s.Key == "" {
+		return nil, nil, errors.New("both a certificate and its key are required to serve TLS")
+	}
+	r, err := newReloader(s.Cert, s.Key, s.ClientCA)
+	if err != nil {
+		return nil, nil, err
+	}
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			config := r.serverConfig()
+			config.NextProtos = []string{"http/1.1"}
+			return config, nil
+		},
+	}
+	return newReloadingCredentials(r.serverConfig), config, nil
+}
+
+// Client configures TLS for the connections to the gateways.
+type Client struct {
+	Enabled bool   `long:"enabled" description:"whether to connect to the gateways with TLS"`
+	CA      string `long:"ca" description:"path to PEM CA certificates verifying the gateways. The system CAs are used if it is not set"`
+	Cert    string `long:"cert" description:"path to the PEM certificate presented to the gateways (mutual TLS)"`
+	Key     string `long:"key" description:"path to the PEM private key of the certificate"`
+}
+
+// Credentials returns the credentials of gRPC clients, which are insecure if TLS is not enabled.
+func (c *Client) Credentials() (credentials.TransportCredentials, error) {
+	if !c.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+	if (c.Cert == "") != (c.Key == "") {
+		return nil, errors.New("a client certificate requires its key")
+	}
+	r, err := newReloader(c.Cert, c.Key, c.CA)
+	if err != nil {
+		return nil, err
+	}
+	return newReloadingCredentials(r.clientConfig), nil
+}
+
+// reloader keeps a certificate, its key and CA certificates loaded from files,
+// reloading them when the files change.
+type reloader struct {
+	cert, key, ca string
+
+	mu          sync.Mutex
+	checked     time.Time
+	modTimes    []time.Time
+	certificate *tls.Certificate
+	pool        *x509.CertPool
+}
+
+func newReloader(cert, key, ca string) (*reloader, error) {
+	r := &reloader{cert: cert, key: key, ca: ca}
+	modTimes, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(modTimes); err != nil {
+		return nil, err
+	}
+	r.checked = time.Now()
+	return r, nil
+}
+
+// stat returns the modification times of the files.
+func (r *reloader) stat() ([]time.Time, error) {
+	var modTimes []time.Time
+	for _, path := range []string{r.cert, r.key, r.ca} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+	return modTimes, nil
+}
+
+func (r *reloader) load(modTimes []time.Time) error {
+	var certificate *tls.Certificate
+	if r.cert != "" {
+		loaded, err := tls.LoadX509KeyPair(r.cert, r.key)
+		if err != nil {
+			return fmt.Errorf("failed to load certificate %s: %w", r.cert, err)
+		}
+		certificate = &loaded
+	}
+	var pool *x509.CertPool
+	if r.ca != "" {
+		data, err := os.ReadFile(r.ca)
+		if err != nil {
+			return fmt.Errorf("failed to read CA certificates: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return fmt.Errorf("no CA certificate found in %s", r.ca)
+		}
+	}
+	r.certificate, r.pool, r.modTimes = certificate, pool, modTimes
+	return nil
+}
+
+// current returns the certificate and the CA certificates, reloaded if the files changed.
+// The previous ones are kept if the files cannot be loaded, e.g. while they are being replaced.
+func (r *reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.checked) >= checkInterval {
+		r.checked = time.Now()
+		if modTimes, err := r.stat(); err == nil && !equalTimes(modTimes, r.modTimes) {
+			_ = r.load(modTimes)
+		}
+	}
+	return r.certificate, r.pool
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *reloader) serverConfig() *tls.Config {
+	certificate, pool := r.current()
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*certificate},
+	}
+	if pool != nil {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = pool
+	}
+	return config
+}
+
+func (r *reloader) clientConfig() *tls.Config {
+	certificate, pool := r.current()
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    pool,
+	}
+	if certificate != nil {
+		config.Certificates = []tls.Certificate{*certificate}
+	}
+	return config
+}
+
+// reloadingCredentials are TLS credentials using the latest config for every handshake.
+type reloadingCredentials struct {
+	credentials.TransportCredentials
+	config func() *tls.Config
+}
+
+func newReloadingCredentials(config func() *tls.Config) credentials.TransportCredentials {
+	return &reloadingCredentials{
+		TransportCredentials: credentials.NewTLS(config()),
+		config:               config,
+	}
+}
+
+func (c *reloadingCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return credentials.NewTLS(c.config()).ClientHandshake(ctx, authority, conn)
+}
+
+func (c *reloadingCredentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return credentials.NewTLS(c.config()).ServerHandshake(conn)
+}
+
+func (c *reloadingCredentials) Clone() credentials.TransportCredentials {
+	return &reloadingCredentials{
+		TransportCredentials: c.TransportCredentials.Clone(),
+		config:               c.config,
+	}
+}
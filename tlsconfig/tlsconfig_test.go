@@ -0,0 +1,129 @@
+package tlsconfig
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/spacemeshos/poet/tlsconfig/tlsconfigtest"
+)
+
+// serve serves a gRPC server without any service with the given TLS config.
+func serve(t *testing.T, cfg *Server) (target string) {
+	creds, _, err := cfg.Load()
+	require.NoError(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.Creds(creds))
+
+	var eg errgroup.Group
+	eg.Go(func() error { return server.Serve(lis) })
+	t.Cleanup(func() { require.NoError(t, eg.Wait()) })
+	t.Cleanup(server.Stop)
+	return fmt.Sprintf("localhost:%d", lis.Addr().(*net.TCPAddr).Port)
+}
+
+// call returns the code of a call to the server, which is Unimplemented if the connection succeeded.
+func call(t *testing.T, target string, cfg *Client) codes.Code {
+	creds, err := cfg.Credentials()
+	require.NoError(t, err)
+	return callWith(t, target, creds)
+}
+
+func callWith(t *testing.T, target string, creds credentials.TransportCredentials) codes.Code {
+	conn, err := grpc.Dial(target, grpc.WithTransportCredentials(creds))
+	require.NoError(t, err)
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err = conn.Invoke(ctx, "/test.Test/Call", &emptypb.Empty{}, &emptypb.Empty{})
+	return status.Code(err)
+}
+
+func TestMutualTLS(t *testing.T) {
+	t.Parallel()
+	ca := tlsconfigtest.NewTestCA(t)
+	serverCert, serverKey := ca.Issue(t, "server")
+	clientCert, clientKey := ca.Issue(t, "client")
+	target := serve(t, &Server{Cert: serverCert, Key: serverKey, ClientCA: ca.File})
+
+	require.Equal(t, codes.Unimplemented, call(t, target, &Client{Enabled: true, CA: ca.File, Cert: clientCert, Key: clientKey}))
+	require.Equal(t, codes.Unavailable, call(t, target, &Client{Enabled: true, CA: ca.File}))
+	require.Equal(t, codes.Unavailable, call(t, target, &Client{}))
+
+	otherCA := tlsconfigtest.NewTestCA(t)
+	otherCert, otherKey := otherCA.Issue(t, "other")
+	require.Equal(t, codes.Unavailable, call(t, target, &Client{Enabled: true, CA: ca.File, Cert: otherCert, Key: otherKey}))
+}
+
+func TestServerTLS(t *testing.T) {
+	t.Parallel()
+	ca := tlsconfigtest.NewTestCA(t)
+	serverCert, serverKey := ca.Issue(t, "server")
+	target := serve(t, &Server{Cert: serverCert, Key: serverKey})
+
+	require.Equal(t, codes.Unimplemented, call(t, target, &Client{Enabled: true, CA: ca.File}))
+	require.Equal(t, codes.Unavailable, call(t, target, &Client{Enabled: true, CA: tlsconfigtest.NewTestCA(t).File}))
+}
+
+func TestInvalidConfig(t *testing.T) {
+	t.Parallel()
+	ca := tlsconfigtest.NewTestCA(t)
+	cert, key := ca.Issue(t, "server")
+
+	_, _, err := (&Server{Cert: cert}).Load()
+	require.Error(t, err)
+	// A client CA alone enables TLS, which requires a certificate.
+	server := &Server{ClientCA: ca.File}
+	require.True(t, server.Enabled())
+	_, _, err = server.Load()
+	require.Error(t, err)
+	_, _, err = (&Server{Cert: cert, Key: cert}).Load()
+	require.Error(t, err)
+	_, _, err = (&Server{Cert: cert, Key: key, ClientCA: key}).Load()
+	require.Error(t, err)
+	_, err = (&Client{Enabled: true, Cert: cert}).Credentials()
+	require.Error(t, err)
+	_, err = (&Client{Enabled: true, CA: "missing"}).Credentials()
+	require.Error(t, err)
+}
+
+func TestReloading(t *testing.T) {
+	checkInterval = 0
+	t.Cleanup(func() { checkInterval = time.Second })
+
+	ca := tlsconfigtest.NewTestCA(t)
+	serverCert, serverKey := ca.Issue(t, "server")
+	target := serve(t, &Server{Cert: serverCert, Key: serverKey})
+	client, err := (&Client{Enabled: true, CA: ca.File}).Credentials()
+	require.NoError(t, err)
+	require.Equal(t, codes.Unimplemented, callWith(t, target, client))
+
+	// Renew the certificate of the server with another CA.
+	otherCA := tlsconfigtest.NewTestCA(t)
+	otherCert, otherKey := otherCA.Issue(t, "server")
+	later := time.Now().Add(time.Minute)
+	for from, to := range map[string]string{otherCert: serverCert, otherKey: serverKey} {
+		require.NoError(t, os.Rename(from, to))
+		require.NoError(t, os.Chtimes(to, later, later))
+	}
+	require.Equal(t, codes.Unavailable, callWith(t, target, client))
+
+	// Trust the other CA.
+	data, err := os.ReadFile(otherCA.File)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(ca.File, data, 0o600))
+	require.NoError(t, os.Chtimes(ca.File, later, later))
+	require.Equal(t, codes.Unimplemented, callWith(t, target, client))
+}
@@ -2,6 +2,7 @@ package verifier
 
 import (
 	"bytes"
+	"crypto/ed25519"
 	"fmt"
 	"sort"
 
@@ -14,13 +15,13 @@ import (
 // leaves matches the security param, validates the Merkle proof itself and verifies the labels are derived from the
 // left cousins in the Merkle tree.
 func Validate(proof shared.MerkleProof, labelHashFunc func(data []byte) []byte,
-	merkleHashFunc func(lChild, rChild []byte) []byte, numLeaves uint64, securityParam uint8,
+	merkleHashFunc func(lChild, rChild []byte) []byte, fiatShamir shared.FiatShamirFunc, numLeaves uint64, securityParam uint8,
 ) error {
 	if int(securityParam) != len(proof.ProvenLeaves) {
 		return fmt.Errorf("number of proven leaves (%d) must be equal to security param (%d)",
 			len(proof.ProvenLeaves), securityParam)
 	}
-	provenLeafIndices := asSortedSlice(shared.FiatShamir(proof.Root, numLeaves, securityParam))
+	provenLeafIndices := asSortedSlice(fiatShamir(proof.Root, numLeaves, securityParam))
 	valid, parkingSnapshots, err := merkle.ValidatePartialTreeWithParkingSnapshots(provenLeafIndices,
 		proof.ProvenLeaves, proof.ProofNodes, proof.Root, merkleHashFunc)
 	if err != nil {
@@ -41,6 +42,34 @@ func Validate(proof shared.MerkleProof, labelHashFunc func(data []byte) []byte,
 	return nil
 }
 
+// ValidateMembership verifies that `member` is the leaf at `index` of the Merkle tree with the given `root`,
+// using the siblings on the path from the leaf to the root provided in `proof`.
+func ValidateMembership(member []byte, index uint64, root []byte, proof [][]byte) error {
+	valid, err := merkle.ValidatePartialTree([]uint64{index}, [][]byte{member}, proof, root, merkle.GetSha256Parent)
+	if err != nil {
+		return fmt.Errorf("error while validating membership proof: %v", err)
+	}
+	if !valid {
+		return fmt.Errorf("membership proof not valid")
+	}
+	return nil
+}
+
+// ValidateSignature verifies that a proof message is signed by the key of the service that published it.
+func ValidateSignature(msg *shared.ProofMessage) error {
+	if len(msg.ServicePubKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid service public key length: %d", len(msg.ServicePubKey))
+	}
+	signed, err := msg.SignedBytes()
+	if err != nil {
+		return fmt.Errorf("failed to encode proof message: %w", err)
+	}
+	if !ed25519.Verify(msg.ServicePubKey, signed, msg.Signature) {
+		return fmt.Errorf("proof signature not valid")
+	}
+	return nil
+}
+
 func asSortedSlice(s map[uint64]bool) []uint64 {
 	var ret []uint64
 	for key, value := range s {
@@ -2,9 +2,11 @@ package verifier
 
 import (
 	"context"
+	"crypto/ed25519"
 	"testing"
 	"time"
 
+	"github.com/spacemeshos/merkle-tree"
 	"github.com/stretchr/testify/require"
 
 	"github.com/spacemeshos/poet/hash"
@@ -21,6 +23,7 @@ func testValidate(t *testing.T, minMemoryLayer uint) {
 		t.TempDir(),
 		hash.GenLabelHashFunc(challenge),
 		hash.GenMerkleHashFunc(challenge),
+		shared.FiatShamir,
 		time.Now().Add(100*time.Millisecond),
 		securityParam,
 		minMemoryLayer,
@@ -30,6 +33,7 @@ func testValidate(t *testing.T, minMemoryLayer uint) {
 		*merkleProof,
 		hash.GenLabelHashFunc(challenge),
 		hash.GenMerkleHashFunc(challenge),
+		shared.FiatShamir,
 		leaves,
 		securityParam,
 	)
@@ -45,6 +49,44 @@ func TestValidate(t *testing.T) {
 	})
 }
 
+func TestValidateHashSuites(t *testing.T) {
+	challenge := []byte("challenge")
+	securityParam := uint8(5)
+	labelDifficulty := uint32(10)
+	for _, name := range hash.SuiteNames() {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			r := require.New(t)
+			suite, err := hash.SuiteByName(name)
+			r.NoError(err)
+			leaves, merkleProof, err := prover.GenerateProofWithoutPersistency(
+				context.Background(),
+				t.TempDir(),
+				suite.LabelHash(challenge, labelDifficulty),
+				suite.MerkleHash(challenge),
+				suite.FiatShamir,
+				time.Now().Add(100*time.Millisecond),
+				securityParam,
+				prover.LowestMerkleMinMemoryLayer,
+			)
+			r.NoError(err)
+			r.NoError(Validate(*merkleProof, suite.LabelHash(challenge, labelDifficulty), suite.MerkleHash(challenge), suite.FiatShamir, leaves, securityParam))
+
+			for _, other := range hash.SuiteNames() {
+				if other == name {
+					continue
+				}
+				otherSuite, err := hash.SuiteByName(other)
+				r.NoError(err)
+				r.Error(Validate(*merkleProof, otherSuite.LabelHash(challenge, labelDifficulty), otherSuite.MerkleHash(challenge), otherSuite.FiatShamir, leaves, securityParam))
+			}
+
+			// The label difficulty is part of the proof.
+			r.Error(Validate(*merkleProof, suite.LabelHash(challenge, labelDifficulty+1), suite.MerkleHash(challenge), suite.FiatShamir, leaves, securityParam))
+		})
+	}
+}
+
 func TestValidateWrongSecParam(t *testing.T) {
 	merkleProof := shared.MerkleProof{
 		Root:         nil,
@@ -58,6 +100,7 @@ func TestValidateWrongSecParam(t *testing.T) {
 		merkleProof,
 		hash.GenLabelHashFunc(challenge),
 		hash.GenMerkleHashFunc(challenge),
+		shared.FiatShamir,
 		numLeaves,
 		securityParam,
 	)
@@ -77,6 +120,7 @@ func TestValidateWrongMerkleValidationError(t *testing.T) {
 		merkleProof,
 		hash.GenLabelHashFunc(challenge),
 		hash.GenMerkleHashFunc(challenge),
+		shared.FiatShamir,
 		numLeaves,
 		securityParam,
 	)
@@ -94,6 +138,7 @@ func TestValidateWrongRoot(t *testing.T) {
 		t.TempDir(),
 		hash.GenLabelHashFunc(challenge),
 		hash.GenMerkleHashFunc(challenge),
+		shared.FiatShamir,
 		time.Now().Add(duration),
 		securityParam,
 		prover.LowestMerkleMinMemoryLayer,
@@ -106,6 +151,7 @@ func TestValidateWrongRoot(t *testing.T) {
 		*merkleProof,
 		hash.GenLabelHashFunc(challenge),
 		hash.GenMerkleHashFunc(challenge),
+		shared.FiatShamir,
 		leafs,
 		securityParam,
 	)
@@ -127,12 +173,79 @@ func TestValidateFailLabelValidation(t *testing.T) {
 		t.TempDir(),
 		hash.GenLabelHashFunc(challenge),
 		hash.GenMerkleHashFunc(challenge),
+		shared.FiatShamir,
 		time.Now().Add(duration),
 		securityParam,
 		prover.LowestMerkleMinMemoryLayer,
 	)
 	r.NoError(err)
-	err = Validate(*merkleProof, BadLabelHashFunc, hash.GenMerkleHashFunc(challenge), leafs, securityParam)
+	err = Validate(*merkleProof, BadLabelHashFunc, hash.GenMerkleHashFunc(challenge), shared.FiatShamir, leafs, securityParam)
 	r.Error(err)
 	r.Regexp("label at index 0 incorrect - expected: [0-f]* actual: [0-f]*", err.Error())
 }
+
+func TestValidateMembership(t *testing.T) {
+	r := require.New(t)
+
+	members := [][]byte{[]byte("member 0"), []byte("member 1"), []byte("member 2")}
+	tree, err := merkle.NewProvingTree(map[uint64]bool{1: true})
+	r.NoError(err)
+	for _, member := range members {
+		r.NoError(tree.AddLeaf(member))
+	}
+	root, proof := tree.RootAndProof()
+
+	r.NoError(ValidateMembership(members[1], 1, root, proof))
+	r.EqualError(ValidateMembership(members[0], 1, root, proof), "membership proof not valid")
+	r.EqualError(ValidateMembership(members[1], 2, root, proof), "membership proof not valid")
+}
+
+func TestValidateSignature(t *testing.T) {
+	r := require.New(t)
+
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	r.NoError(err)
+	msg := &shared.ProofMessage{
+		Version: shared.ProofMessageVersion,
+		Proof: shared.Proof{
+			MerkleProof: shared.MerkleProof{Root: []byte("root")},
+			Members:     [][]byte{[]byte("member")},
+			NumLeaves:   16,
+		},
+		ServicePubKey:   pubKey,
+		RoundID:         "1",
+		Epoch:           1,
+		RoundStart:      1000,
+		RoundEnd:        2000,
+		Statement:       []byte("statement"),
+		SecurityParam:   5,
+		LabelDifficulty: 10,
+		HashSuite:       hash.SHA256,
+		NodeIDs:         [][]byte{[]byte("node")},
+	}
+	signed, err := msg.SignedBytes()
+	r.NoError(err)
+	msg.Signature = ed25519.Sign(privKey, signed)
+	r.NoError(ValidateSignature(msg))
+
+	// The node IDs are not covered by the signature.
+	msg.NodeIDs = nil
+	r.NoError(ValidateSignature(msg))
+
+	// The metadata of the envelope is.
+	msg.RoundEnd++
+	r.EqualError(ValidateSignature(msg), "proof signature not valid")
+	msg.RoundEnd--
+
+	msg.LabelDifficulty++
+	r.EqualError(ValidateSignature(msg), "proof signature not valid")
+	msg.LabelDifficulty--
+
+	msg.ServicePubKey = nil
+	r.EqualError(ValidateSignature(msg), "invalid service public key length: 0")
+	msg.ServicePubKey = pubKey
+
+	// Messages of version 0 are not signed.
+	msg.Version = 0
+	r.ErrorIs(ValidateSignature(msg), shared.ErrUnsignedProofMessage)
+}
@@ -0,0 +1,323 @@
+// Package webhook notifies HTTP endpoints of the proofs generated by poet.
+// The notifications go through a persistent outbox, so that they survive restarts,
+// and their delivery is retried with backoff.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	xdr "github.com/nullstyle/go-xdr/xdr3"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"go.uber.org/zap"
+
+	"github.com/spacemeshos/poet/logging"
+)
+
+const (
+	// SignatureHeader is the header carrying the signature of a notification:
+	// "sha256=" followed by the hex-encoded HMAC-SHA256 of the body keyed with the secret.
+	SignatureHeader = "X-Poet-Signature"
+
+	DefaultTimeout     = 10 * time.Second
+	DefaultMaxAttempts = 20
+)
+
+var (
+	// deliveryPrefix prefixes the keys of the deliveries: deliveryPrefix || big-endian sequence number.
+	deliveryPrefix = []byte("d")
+	// roundPrefix prefixes the keys marking the rounds notified: roundPrefix || round ID.
+	roundPrefix = []byte("r")
+)
+
+// Config configures the webhooks notified of new proofs.
+type Config struct {
+	URLs        []string      `long:"url" description:"URL of a webhook notified with a POST of every new proof. May be repeated"`
+	Secret      string        `long:"secret" description:"Key signing the notifications with HMAC-SHA256, in the X-Poet-Signature header"`
+	Timeout     time.Duration `long:"timeout" description:"Timeout of a delivery to a webhook"`
+	MaxAttempts uint          `long:"max-attempts" description:"Number of attempts to deliver a notification before it is dropped (0 for no limit)"`
+}
+
+// Enabled returns whether webhooks are configured.
+func (c *Config) Enabled() bool {
+	return len(c.URLs) != 0
+}
+
+// Notification is the JSON body posted to the webhooks when a proof is stored.
+type Notification struct {
+	RoundID string `json:"round_id"`
+	// Root is the hex-encoded root of the proof.
+	Root    string `json:"root"`
+	Leaves  uint64 `json:"leaves"`
+	Members int    `json:"members"`
+}
+
+// Sign returns the value of the SignatureHeader of the body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type NotifierOption func(*Notifier)
+
+// WithBackoff sets the delay before the first retry of a delivery, doubled for every retry up to `max`.
+func WithBackoff(base, max time.Duration) NotifierOption {
+	return func(n *Notifier) {
+		n.backoffBase = base
+		n.maxBackoff = max
+	}
+}
+
+// delivery is a notification waiting in the outbox to be delivered to a webhook.
+type delivery struct {
+	URL         string
+	Body        []byte
+	Attempts    uint32
+	NextAttempt int64
+}
+
+// Notifier delivers the notifications to the webhooks.
+// The outbox stores the deliveries, and marks the rounds whose notification was stored
+// so that a round is notified only once.
+type Notifier struct {
+	cfg         Config
+	db          *leveldb.DB
+	client      *http.Client
+	backoffBase time.Duration
+	maxBackoff  time.Duration
+
+	// sequence is the key of the next delivery, only accessed by Notify.
+	sequence uint64
+	wake     chan struct{}
+}
+
+// Open opens the outbox stored in the database at `path`.
+func Open(path string, cfg Config, opts ...NotifierOption) (*Notifier, error) {
+	if cfg.Secret == "" {
+		return nil, errors.New("webhooks require a secret signing the notifications")
+	}
+	n := &Notifier{
+		cfg:         cfg,
+		client:      &http.Client{Timeout: cfg.Timeout},
+		backoffBase: time.Second,
+		maxBackoff:  time.Hour,
+		wake:        make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database @ %s: %w", path, err)
+	}
+	n.db = db
+
+	iter := db.NewIterator(util.BytesPrefix(deliveryPrefix), nil)
+	if iter.Last() {
+		n.sequence = binary.BigEndian.Uint64(iter.Key()[len(deliveryPrefix):]) + 1
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to read the outbox: %w", err)
+	}
+	return n, nil
+}
+
+// Close closes the database of the outbox.
+func (n *Notifier) Close() error {
+	return n.db.Close()
+}
+
+// Notify stores the deliveries of the notification to every webhook in the outbox.
+// A round is notified once: Notify does nothing if the notification of the round is already stored.
+// It must not be called concurrently.
+func (n *Notifier) Notify(notification Notification) error {
+	roundKey := append(append([]byte(nil), roundPrefix...), notification.RoundID...)
+	if notified, err := n.db.Has(roundKey, nil); err != nil {
+		return fmt.Errorf("failed to read the outbox: %w", err)
+	} else if notified {
+		return nil
+	}
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	batch.Put(roundKey, nil)
+	now := time.Now().UnixNano()
+	for _, url := range n.cfg.URLs {
+		value, err := encodeDelivery(&delivery{URL: url, Body: body, NextAttempt: now})
+		if err != nil {
+			return err
+		}
+		batch.Put(binary.BigEndian.AppendUint64(append([]byte(nil), deliveryPrefix...), n.sequence), value)
+		n.sequence++
+	}
+	if err := n.db.Write(batch, &opt.WriteOptions{Sync: true}); err != nil {
+		return fmt.Errorf("failed to store the notification in the outbox: %w", err)
+	}
+
+	select {
+	case n.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Run delivers the notifications of the outbox until the context is canceled.
+// It only returns on shutdown: the failures to read or update the outbox are logged and retried later.
+func (n *Notifier) Run(ctx context.Context) error {
+	logger := logging.FromContext(ctx).Named("webhook")
+	for {
+		next := n.deliverDue(ctx, logger)
+		wait := n.maxBackoff
+		if !next.IsZero() {
+			wait = time.Until(next)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-n.wake:
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+}
+
+// deliverDue attempts the deliveries that are due and returns when the next one is.
+// The deliveries that fail to decode are dropped, and the outbox is read again
+// after the base backoff when it fails to be read or updated.
+func (n *Notifier) deliverDue(ctx context.Context, logger *zap.Logger) time.Time {
+	iter := n.db.NewIterator(util.BytesPrefix(deliveryPrefix), nil)
+	defer iter.Release()
+
+	var next time.Time
+	schedule := func(at time.Time) {
+		if next.IsZero() || at.Before(next) {
+			next = at
+		}
+	}
+	// update writes the delivery, or deletes it if `value` is nil, and schedules a retry if it fails.
+	update := func(key, value []byte) {
+		var err error
+		if value == nil {
+			err = n.db.Delete(key, &opt.WriteOptions{Sync: true})
+		} else {
+			err = n.db.Put(key, value, &opt.WriteOptions{Sync: true})
+		}
+		if err != nil {
+			logger.Error("failed to update the outbox, retrying later", zap.Binary("key", key), zap.Error(err))
+			schedule(time.Now().Add(n.backoffBase))
+		}
+	}
+	for iter.Next() && ctx.Err() == nil {
+		key := append([]byte(nil), iter.Key()...)
+		d, err := decodeDelivery(iter.Value())
+		if err != nil {
+			logger.Error("dropping notification that failed to decode", zap.Binary("key", key), zap.Error(err))
+			update(key, nil)
+			continue
+		}
+		if nextAttempt := time.Unix(0, d.NextAttempt); time.Now().Before(nextAttempt) {
+			schedule(nextAttempt)
+			continue
+		}
+
+		err = n.deliver(ctx, d)
+		switch {
+		case err == nil:
+			logger.Debug("delivered notification", zap.String("url", d.URL))
+			update(key, nil)
+			continue
+		case ctx.Err() != nil:
+			return next
+		}
+
+		d.Attempts++
+		if n.cfg.MaxAttempts != 0 && d.Attempts >= uint32(n.cfg.MaxAttempts) {
+			logger.Error("dropping notification", zap.String("url", d.URL), zap.Uint32("attempts", d.Attempts), zap.Error(err))
+			update(key, nil)
+			continue
+		}
+		retry := time.Now().Add(n.backoff(d.Attempts))
+		logger.Warn("failed to deliver notification", zap.String("url", d.URL), zap.Time("retry", retry), zap.Error(err))
+		d.NextAttempt = retry.UnixNano()
+		if value, err := encodeDelivery(d); err != nil {
+			logger.Error("failed to serialize delivery", zap.String("url", d.URL), zap.Error(err))
+		} else {
+			update(key, value)
+		}
+		schedule(retry)
+	}
+	if err := iter.Error(); err != nil {
+		logger.Error("failed to read the outbox, retrying later", zap.Error(err))
+		schedule(time.Now().Add(n.backoffBase))
+	}
+	return next
+}
+
+// backoff returns the delay before the next attempt of a delivery attempted `attempts` times.
+func (n *Notifier) backoff(attempts uint32) time.Duration {
+	delay := n.backoffBase
+	for i := uint32(1); i < attempts && delay < n.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > n.maxBackoff {
+		delay = n.maxBackoff
+	}
+	return delay
+}
+
+func (n *Notifier) deliver(ctx context.Context, d *delivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign([]byte(n.cfg.Secret), d.Body))
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
+
+func encodeDelivery(d *delivery) ([]byte, error) {
+	var data bytes.Buffer
+	if _, err := xdr.Marshal(&data, d); err != nil {
+		return nil, fmt.Errorf("serialization failure: %w", err)
+	}
+	return data.Bytes(), nil
+}
+
+func decodeDelivery(data []byte) (*delivery, error) {
+	var d delivery
+	if _, err := xdr.Unmarshal(bytes.NewReader(data), &d); err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
@@ -0,0 +1,188 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/spacemeshos/poet/webhook"
+)
+
+const secret = "secret"
+
+// endpoint is a webhook recording the notifications it receives.
+// It fails the first `failures` deliveries.
+type endpoint struct {
+	failures int32
+	requests atomic.Int32
+
+	mu            sync.Mutex
+	notifications []webhook.Notification
+}
+
+func (e *endpoint) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if e.requests.Add(1) <= e.failures {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil || r.Header.Get(webhook.SignatureHeader) != webhook.Sign([]byte(secret), body) {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	var notification webhook.Notification
+	if err := json.Unmarshal(body, &notification); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.notifications = append(e.notifications, notification)
+}
+
+func (e *endpoint) received() []webhook.Notification {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return append([]webhook.Notification(nil), e.notifications...)
+}
+
+func serve(t *testing.T, e *endpoint) string {
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func run(t *testing.T, notifier *webhook.Notifier) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var eg errgroup.Group
+	eg.Go(func() error { return notifier.Run(ctx) })
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, eg.Wait())
+	})
+}
+
+func TestNotifier(t *testing.T) {
+	t.Parallel()
+	notification := webhook.Notification{RoundID: "1", Root: "abcd", Leaves: 100, Members: 3}
+
+	t.Run("delivers signed notifications to every webhook", func(t *testing.T) {
+		t.Parallel()
+		first, second := &endpoint{}, &endpoint{}
+		cfg := webhook.Config{URLs: []string{serve(t, first), serve(t, second)}, Secret: secret, Timeout: time.Second}
+		notifier, err := webhook.Open(filepath.Join(t.TempDir(), "outbox"), cfg)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, notifier.Close()) })
+		run(t, notifier)
+
+		require.NoError(t, notifier.Notify(notification))
+		for _, e := range []*endpoint{first, second} {
+			require.Eventually(t, func() bool { return len(e.received()) == 1 }, time.Second, time.Millisecond)
+			require.Equal(t, notification, e.received()[0])
+		}
+	})
+	t.Run("retries with backoff", func(t *testing.T) {
+		t.Parallel()
+		e := &endpoint{failures: 2}
+		cfg := webhook.Config{URLs: []string{serve(t, e)}, Secret: secret, Timeout: time.Second}
+		notifier, err := webhook.Open(filepath.Join(t.TempDir(), "outbox"), cfg, webhook.WithBackoff(time.Millisecond, 10*time.Millisecond))
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, notifier.Close()) })
+		run(t, notifier)
+
+		require.NoError(t, notifier.Notify(notification))
+		require.Eventually(t, func() bool { return len(e.received()) == 1 }, time.Second, time.Millisecond)
+		require.EqualValues(t, 3, e.requests.Load())
+	})
+	t.Run("drops notifications after the max attempts", func(t *testing.T) {
+		t.Parallel()
+		e := &endpoint{failures: 1000}
+		cfg := webhook.Config{URLs: []string{serve(t, e)}, Secret: secret, Timeout: time.Second, MaxAttempts: 2}
+		notifier, err := webhook.Open(filepath.Join(t.TempDir(), "outbox"), cfg, webhook.WithBackoff(time.Millisecond, time.Millisecond))
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, notifier.Close()) })
+		run(t, notifier)
+
+		require.NoError(t, notifier.Notify(notification))
+		require.Eventually(t, func() bool { return e.requests.Load() == 2 }, time.Second, time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+		require.EqualValues(t, 2, e.requests.Load())
+	})
+	t.Run("survives restarts", func(t *testing.T) {
+		t.Parallel()
+		e := &endpoint{}
+		path := filepath.Join(t.TempDir(), "outbox")
+		cfg := webhook.Config{URLs: []string{serve(t, e)}, Secret: secret, Timeout: time.Second}
+		notifier, err := webhook.Open(path, cfg)
+		require.NoError(t, err)
+		require.NoError(t, notifier.Notify(notification))
+		require.NoError(t, notifier.Close())
+
+		notifier, err = webhook.Open(path, cfg)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, notifier.Close()) })
+		other := webhook.Notification{RoundID: "2"}
+		require.NoError(t, notifier.Notify(other))
+		run(t, notifier)
+
+		require.Eventually(t, func() bool { return len(e.received()) == 2 }, time.Second, time.Millisecond)
+		require.Equal(t, []webhook.Notification{notification, other}, e.received())
+	})
+	t.Run("notifies a round once", func(t *testing.T) {
+		t.Parallel()
+		e := &endpoint{}
+		path := filepath.Join(t.TempDir(), "outbox")
+		cfg := webhook.Config{URLs: []string{serve(t, e)}, Secret: secret, Timeout: time.Second}
+		notifier, err := webhook.Open(path, cfg)
+		require.NoError(t, err)
+		require.NoError(t, notifier.Notify(notification))
+		require.NoError(t, notifier.Notify(notification))
+		require.NoError(t, notifier.Close())
+
+		notifier, err = webhook.Open(path, cfg)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, notifier.Close()) })
+		require.NoError(t, notifier.Notify(notification))
+		run(t, notifier)
+
+		require.Eventually(t, func() bool { return len(e.received()) == 1 }, time.Second, time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+		require.EqualValues(t, 1, e.requests.Load())
+	})
+	t.Run("drops notifications that fail to decode", func(t *testing.T) {
+		t.Parallel()
+		e := &endpoint{}
+		path := filepath.Join(t.TempDir(), "outbox")
+		db, err := leveldb.OpenFile(path, nil)
+		require.NoError(t, err)
+		require.NoError(t, db.Put(binary.BigEndian.AppendUint64([]byte("d"), 0), []byte("x"), nil))
+		require.NoError(t, db.Close())
+
+		cfg := webhook.Config{URLs: []string{serve(t, e)}, Secret: secret, Timeout: time.Second}
+		notifier, err := webhook.Open(path, cfg)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, notifier.Close()) })
+		run(t, notifier)
+
+		require.NoError(t, notifier.Notify(notification))
+		require.Eventually(t, func() bool { return len(e.received()) == 1 }, time.Second, time.Millisecond)
+		require.Equal(t, notification, e.received()[0])
+	})
+	t.Run("requires a secret", func(t *testing.T) {
+		t.Parallel()
+		_, err := webhook.Open(filepath.Join(t.TempDir(), "outbox"), webhook.Config{URLs: []string{"http://localhost"}})
+		require.Error(t, err)
+	})
+}